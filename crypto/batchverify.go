@@ -0,0 +1,126 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"runtime"
+	"sync"
+
+	"github.com/iotexproject/iotex-address/address"
+)
+
+type (
+	// VerifyItem is a signature to be verified against the public key
+	VerifyItem struct {
+		PubKey PublicKey
+		Hash   []byte
+		Sig    []byte
+	}
+
+	// RecoverItem is a signature whose signer is identified only by address, it is
+	// verified by recovering the public key and comparing its address
+	RecoverItem struct {
+		Address address.Address
+		Hash    []byte
+		Sig     []byte
+	}
+
+	// BatchVerifier verifies signatures of mixed key types in parallel
+	BatchVerifier struct {
+		workers int
+	}
+
+	// BatchVerifierOption is an option of BatchVerifier
+	BatchVerifierOption func(*BatchVerifier)
+)
+
+// VerifierWorkerNum sets the number of workers, default to the number of CPUs
+func VerifierWorkerNum(n int) BatchVerifierOption {
+	return func(bv *BatchVerifier) {
+		if n > 0 {
+			bv.workers = n
+		}
+	}
+}
+
+// NewBatchVerifier creates an instance of batch verifier
+func NewBatchVerifier(opts ...BatchVerifierOption) *BatchVerifier {
+	bv := &BatchVerifier{
+		workers: runtime.NumCPU(),
+	}
+	for _, opt := range opts {
+		opt(bv)
+	}
+	return bv
+}
+
+// Verify verifies the signatures, and returns the result of each item, along
+// with whether all items pass the verification
+func (bv *BatchVerifier) Verify(items []VerifyItem) ([]bool, bool) {
+	return bv.run(len(items), func(i int) bool {
+		e := items[i]
+		return e.PubKey != nil && e.PubKey.Verify(e.Hash, e.Sig)
+	})
+}
+
+// VerifyRecover recovers the public key from each signature and compares its
+// address to the expected one, and returns the result of each item, along with
+// whether all items pass the verification
+func (bv *BatchVerifier) VerifyRecover(items []RecoverItem) ([]bool, bool) {
+	return bv.run(len(items), func(i int) bool {
+		e := items[i]
+		if e.Address == nil {
+			return false
+		}
+		// RecoverPubkey may temporarily modify the signature, make a copy in case
+		// the same signature is shared by multiple items
+		sig := make([]byte, len(e.Sig))
+		copy(sig, e.Sig)
+		pk, err := RecoverPubkey(e.Hash, sig)
+		if err != nil {
+			return false
+		}
+		return pk.Address().String() == e.Address.String()
+	})
+}
+
+func (bv *BatchVerifier) run(size int, verify func(int) bool) ([]bool, bool) {
+	results := make([]bool, size)
+	if size == 0 {
+		return results, true
+	}
+
+	workers := bv.workers
+	if workers > size {
+		workers = size
+	}
+	var (
+		wg    sync.WaitGroup
+		tasks = make(chan int, size)
+	)
+	for i := 0; i < size; i++ {
+		tasks <- i
+	}
+	close(tasks)
+	wg.Add(workers)
+	for w := 0; w < workers; w++ {
+		go func() {
+			defer wg.Done()
+			for i := range tasks {
+				results[i] = verify(i)
+			}
+		}()
+	}
+	wg.Wait()
+
+	for _, ok := range results {
+		if !ok {
+			return results, false
+		}
+	}
+	return results, true
+}
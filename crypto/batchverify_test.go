@@ -0,0 +1,116 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/byteutil"
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+func makeVerifyItems(t require.TestingT, size int) ([]VerifyItem, []RecoverItem) {
+	require := require.New(t)
+
+	var (
+		items    = make([]VerifyItem, size)
+		recovers = make([]RecoverItem, size)
+	)
+	for i := 0; i < size; i++ {
+		var (
+			sk  PrivateKey
+			sig []byte
+			err error
+		)
+		h := hash.Hash256b(byteutil.Uint64ToBytes(uint64(i)))
+		if i%2 == 0 {
+			sk, err = GenerateKey()
+			require.NoError(err)
+			sig, err = sk.Sign(h[:])
+		} else {
+			sk, err = GenerateKeySm2()
+			require.NoError(err)
+			sig, err = sk.(*P256sm2PrvKey).SignWithOptions(h[:], WithRecoveryID())
+		}
+		require.NoError(err)
+		items[i] = VerifyItem{sk.PublicKey(), h[:], sig}
+		recovers[i] = RecoverItem{sk.PublicKey().Address(), h[:], sig}
+	}
+	return items, recovers
+}
+
+func TestBatchVerifier(t *testing.T) {
+	require := require.New(t)
+
+	items, recovers := makeVerifyItems(t, 20)
+	for _, bv := range []*BatchVerifier{
+		NewBatchVerifier(),
+		NewBatchVerifier(VerifierWorkerNum(1)),
+		NewBatchVerifier(VerifierWorkerNum(64)),
+	} {
+		res, ok := bv.Verify(items)
+		require.True(ok)
+		require.Equal(len(items), len(res))
+		res, ok = bv.VerifyRecover(recovers)
+		require.True(ok)
+		require.Equal(len(recovers), len(res))
+
+		res, ok = bv.Verify(nil)
+		require.True(ok)
+		require.Empty(res)
+	}
+
+	// tamper some of the items
+	bv := NewBatchVerifier(VerifierWorkerNum(4))
+	items[3].Hash = items[4].Hash
+	items[6].PubKey = items[7].PubKey
+	items[9].PubKey = nil
+	recovers[3].Hash = recovers[4].Hash
+	recovers[6].Address = recovers[7].Address
+	recovers[9].Address = nil
+	for _, res := range [][]bool{
+		func() []bool { res, ok := bv.Verify(items); require.False(ok); return res }(),
+		func() []bool { res, ok := bv.VerifyRecover(recovers); require.False(ok); return res }(),
+	} {
+		for i, r := range res {
+			require.Equal(i != 3 && i != 6 && i != 9, r)
+		}
+	}
+}
+
+func BenchmarkVerify(b *testing.B) {
+	items, recovers := makeVerifyItems(b, 1000)
+	bv := NewBatchVerifier()
+
+	b.Run("sequential", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			for _, e := range items {
+				e.PubKey.Verify(e.Hash, e.Sig)
+			}
+		}
+	})
+	b.Run("batch", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			bv.Verify(items)
+		}
+	})
+	b.Run("sequential-recover", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			for _, e := range recovers {
+				pk, _ := RecoverPubkey(e.Hash, e.Sig)
+				_ = pk.Address().String() == e.Address.String()
+			}
+		}
+	})
+	b.Run("batch-recover", func(b *testing.B) {
+		for n := 0; n < b.N; n++ {
+			bv.VerifyRecover(recovers)
+		}
+	})
+}
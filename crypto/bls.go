@@ -0,0 +1,370 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"math/big"
+
+	bls12381 "github.com/consensys/gnark-crypto/ecc/bls12-381"
+	"github.com/consensys/gnark-crypto/ecc/bls12-381/fr"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+// BLS signature over BLS12-381 in the minimal-pubkey-size variant of the IETF
+// BLS signature draft (same as Ethereum 2.0): public key in G1, signature in G2,
+// with proof-of-possession scheme against rogue-key attack
+const (
+	// BLSPubKeySize is the size of compressed G1 point
+	BLSPubKeySize = bls12381.SizeOfG1AffineCompressed
+	// BLSPrvKeySize is the size of secret scalar
+	BLSPrvKeySize = fr.Bytes
+	// BLSSigSize is the size of compressed G2 point
+	BLSSigSize = bls12381.SizeOfG2AffineCompressed
+)
+
+var (
+	blsSigDST = []byte("BLS_SIG_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+	blsPopDST = []byte("BLS_POP_BLS12381G2_XMD:SHA-256_SSWU_RO_POP_")
+
+	blsKeyGenSalt = []byte("BLS-SIG-KEYGEN-SALT-")
+)
+
+type (
+	// blsPrvKey implements the BLS12-381 private key
+	blsPrvKey struct {
+		sk *big.Int
+	}
+	// blsPubKey implements the BLS12-381 public key
+	blsPubKey struct {
+		pk bls12381.G1Affine
+	}
+)
+
+// GenerateKeyBLS generates a BLS12-381 PrivateKey
+func GenerateKeyBLS() (PrivateKey, error) {
+	ikm := make([]byte, 32)
+	if _, err := io.ReadFull(rand.Reader, ikm); err != nil {
+		return nil, errors.Wrap(err, "failed to create bls private key")
+	}
+	return newBLSPrvKeyFromIKM(ikm)
+}
+
+// BytesToBLSPrivateKey converts 32-byte big-endian secret to BLS12-381 PrivateKey
+//
+// BytesToPrivateKey cannot tell it apart from SECP256K1 key of the same size,
+// so it has to be called explicitly
+func BytesToBLSPrivateKey(b []byte) (PrivateKey, error) {
+	if len(b) != BLSPrvKeySize {
+		return nil, ErrPrivateKey
+	}
+	sk := new(big.Int).SetBytes(b)
+	if sk.Sign() == 0 || sk.Cmp(fr.Modulus()) >= 0 {
+		return nil, ErrPrivateKey
+	}
+	return &blsPrvKey{
+		sk: sk,
+	}, nil
+}
+
+// AggregateBLSSignatures aggregates the BLS signatures into one
+func AggregateBLSSignatures(sigs [][]byte) ([]byte, error) {
+	if len(sigs) == 0 {
+		return nil, errors.New("no signature to aggregate")
+	}
+	var agg bls12381.G2Jac
+	for _, sig := range sigs {
+		p, err := blsSigFromBytes(sig)
+		if err != nil {
+			return nil, err
+		}
+		agg.AddMixed(p)
+	}
+	var p bls12381.G2Affine
+	p.FromJacobian(&agg)
+	b := p.Bytes()
+	return b[:], nil
+}
+
+// AggregateBLSPublicKeys aggregates the BLS public keys into one
+func AggregateBLSPublicKeys(pks []PublicKey) (PublicKey, error) {
+	if len(pks) == 0 {
+		return nil, errors.New("no public key to aggregate")
+	}
+	var agg bls12381.G1Jac
+	for _, pk := range pks {
+		k, ok := pk.(*blsPubKey)
+		if !ok {
+			return nil, errors.Wrapf(ErrPublicKey, "%T is not bls public key", pk)
+		}
+		agg.AddMixed(&k.pk)
+	}
+	k := &blsPubKey{}
+	k.pk.FromJacobian(&agg)
+	if k.pk.IsInfinity() {
+		return nil, errors.Wrap(ErrPublicKey, "aggregated public key is infinity")
+	}
+	return k, nil
+}
+
+// FastAggregateVerifyBLS verifies the aggregated signature of the same message
+// signed by all public keys
+//
+// the public keys must have their proof-of-possession verified beforehand,
+// otherwise it is subject to rogue-key attack
+func FastAggregateVerifyBLS(pks []PublicKey, msg, sig []byte) bool {
+	pk, err := AggregateBLSPublicKeys(pks)
+	if err != nil {
+		return false
+	}
+	return pk.Verify(msg, sig)
+}
+
+// ProveBLSPossession generates the proof-of-possession of the BLS private key
+func ProveBLSPossession(sk PrivateKey) ([]byte, error) {
+	k, ok := sk.(*blsPrvKey)
+	if !ok {
+		return nil, errors.Wrapf(ErrPrivateKey, "%T is not bls private key", sk)
+	}
+	return k.sign(sk.PublicKey().Bytes(), blsPopDST)
+}
+
+// VerifyBLSPossession verifies the proof-of-possession of the BLS public key
+func VerifyBLSPossession(pk PublicKey, proof []byte) bool {
+	k, ok := pk.(*blsPubKey)
+	if !ok {
+		return false
+	}
+	return k.verify(k.Bytes(), proof, blsPopDST)
+}
+
+//======================================
+// PrivateKey function
+//======================================
+
+// newBLSPrvKeyFromIKM derives the private key from input keying material, per KeyGen in IETF draft
+func newBLSPrvKeyFromIKM(ikm []byte) (PrivateKey, error) {
+	var (
+		salt   = blsKeyGenSalt
+		secret = append(append([]byte{}, ikm...), 0)
+		// L = ceil((3 * ceil(log2(r))) / 16) = 48
+		okm = make([]byte, 48)
+		sk  = new(big.Int)
+	)
+	for sk.Sign() == 0 {
+		h := sha256.Sum256(salt)
+		salt = h[:]
+		prk := hkdf.Extract(sha256.New, secret, salt)
+		if _, err := io.ReadFull(hkdf.Expand(sha256.New, prk, []byte{0, byte(len(okm))}), okm); err != nil {
+			return nil, err
+		}
+		sk.SetBytes(okm)
+		sk.Mod(sk, fr.Modulus())
+	}
+	return &blsPrvKey{
+		sk: sk,
+	}, nil
+}
+
+// Bytes returns the private key in 32-byte big-endian
+func (k *blsPrvKey) Bytes() []byte {
+	b := make([]byte, BLSPrvKeySize)
+	return k.sk.FillBytes(b)
+}
+
+// HexString returns the private key in hex string
+func (k *blsPrvKey) HexString() string {
+	return hex.EncodeToString(k.Bytes())
+}
+
+// EcdsaPrivateKey returns the secret scalar
+func (k *blsPrvKey) EcdsaPrivateKey() interface{} {
+	return k.sk
+}
+
+// PublicKey returns the public key corresponding to private key
+func (k *blsPrvKey) PublicKey() PublicKey {
+	pk := &blsPubKey{}
+	pk.pk.ScalarMultiplicationBase(k.sk)
+	return pk
+}
+
+// Sign signs the message/hash
+func (k *blsPrvKey) Sign(msg []byte) ([]byte, error) {
+	return k.sign(msg, blsSigDST)
+}
+
+func (k *blsPrvKey) sign(msg, dst []byte) ([]byte, error) {
+	h, err := bls12381.HashToG2(msg, dst)
+	if err != nil {
+		return nil, err
+	}
+	var sig bls12381.G2Affine
+	sig.ScalarMultiplication(&h, k.sk)
+	b := sig.Bytes()
+	return b[:], nil
+}
+
+// Zero zeroes the private key data
+func (k *blsPrvKey) Zero() {
+	b := k.sk.Bits()
+	for i := range b {
+		b[i] = 0
+	}
+}
+
+// KeyType returns the key type
+func (k *blsPrvKey) KeyType() KeyType {
+	return KeyTypeBLS12381
+}
+
+// MarshalText encodes the private key to the hex string of tagged encoding
+func (k *blsPrvKey) MarshalText() ([]byte, error) {
+	return marshalPrivateKeyText(k)
+}
+
+// MarshalJSON encodes the private key to JSON string of MarshalText
+func (k *blsPrvKey) MarshalJSON() ([]byte, error) {
+	return marshalKeyJSON(k.MarshalText())
+}
+
+// UnmarshalText decodes the private key from the hex string of tagged encoding
+func (k *blsPrvKey) UnmarshalText(text []byte) error {
+	key, err := unmarshalPrivateKeyText(text, KeyTypeBLS12381)
+	if err != nil {
+		return err
+	}
+	*k = *key.(*blsPrvKey)
+	return nil
+}
+
+//======================================
+// PublicKey function
+//======================================
+
+// newBLSPubKeyFromBytes converts the compressed G1 point to PublicKey
+func newBLSPubKeyFromBytes(b []byte) (PublicKey, error) {
+	if len(b) != BLSPubKeySize {
+		return nil, ErrPublicKey
+	}
+	k := &blsPubKey{}
+	// SetBytes checks the point is on curve and in the subgroup
+	if _, err := k.pk.SetBytes(b); err != nil {
+		return nil, errors.Wrap(ErrPublicKey, err.Error())
+	}
+	if k.pk.IsInfinity() {
+		return nil, ErrPublicKey
+	}
+	return k, nil
+}
+
+// Bytes returns the public key in compressed G1 point
+func (k *blsPubKey) Bytes() []byte {
+	b := k.pk.Bytes()
+	return b[:]
+}
+
+// CompressedBytes returns the public key in compressed G1 point, same as Bytes
+func (k *blsPubKey) CompressedBytes() []byte {
+	return k.Bytes()
+}
+
+// HexString returns the public key in hex string
+func (k *blsPubKey) HexString() string {
+	return hex.EncodeToString(k.Bytes())
+}
+
+// EcdsaPublicKey returns the G1 point
+func (k *blsPubKey) EcdsaPublicKey() interface{} {
+	return &k.pk
+}
+
+// Hash is the last 20-byte of keccak hash of the compressed public key
+func (k *blsPubKey) Hash() []byte {
+	h := hash.Hash160b(k.Bytes())
+	return h[:]
+}
+
+// Verify verifies the signature
+func (k *blsPubKey) Verify(msg, sig []byte) bool {
+	return k.verify(msg, sig, blsSigDST)
+}
+
+// verify checks e(pk, H(msg)) == e(G1, sig)
+func (k *blsPubKey) verify(msg, sig, dst []byte) bool {
+	s, err := blsSigFromBytes(sig)
+	if err != nil {
+		return false
+	}
+	h, err := bls12381.HashToG2(msg, dst)
+	if err != nil {
+		return false
+	}
+	_, _, g1, _ := bls12381.Generators()
+	var negG1 bls12381.G1Affine
+	negG1.Neg(&g1)
+	ok, err := bls12381.PairingCheck([]bls12381.G1Affine{negG1, k.pk}, []bls12381.G2Affine{*s, h})
+	return err == nil && ok
+}
+
+// VerifySignature returns false, Signature does not hold BLS signature
+func (k *blsPubKey) VerifySignature(msg []byte, sig *Signature) bool {
+	return false
+}
+
+// Address returns the address object
+func (k *blsPubKey) Address() address.Address {
+	addr, _ := address.FromBytes(k.Hash())
+	return addr
+}
+
+// KeyType returns the key type
+func (k *blsPubKey) KeyType() KeyType {
+	return KeyTypeBLS12381
+}
+
+// MarshalText encodes the public key to the hex string of tagged encoding
+func (k *blsPubKey) MarshalText() ([]byte, error) {
+	return marshalPublicKeyText(k)
+}
+
+// MarshalJSON encodes the public key to JSON string of MarshalText
+func (k *blsPubKey) MarshalJSON() ([]byte, error) {
+	return marshalKeyJSON(k.MarshalText())
+}
+
+// UnmarshalText decodes the public key from the hex string of tagged encoding
+func (k *blsPubKey) UnmarshalText(text []byte) error {
+	key, err := unmarshalPublicKeyText(text, KeyTypeBLS12381)
+	if err != nil {
+		return err
+	}
+	*k = *key.(*blsPubKey)
+	return nil
+}
+
+func blsSigFromBytes(b []byte) (*bls12381.G2Affine, error) {
+	if len(b) != BLSSigSize {
+		return nil, errors.Errorf("invalid bls signature length %d", len(b))
+	}
+	var p bls12381.G2Affine
+	// SetBytes checks the point is on curve and in the subgroup
+	if _, err := p.SetBytes(b); err != nil {
+		return nil, err
+	}
+	if p.IsInfinity() {
+		return nil, errors.New("bls signature is infinity")
+	}
+	return &p, nil
+}
@@ -0,0 +1,113 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestBLS(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := GenerateKeyBLS()
+	require.NoError(err)
+	require.Equal(BLSPrvKeySize, len(sk.Bytes()))
+	pk := sk.PublicKey()
+	require.Equal(BLSPubKeySize, len(pk.Bytes()))
+	require.Equal(pk.Bytes(), pk.CompressedBytes())
+	require.Equal(pk.Hash(), pk.Address().Bytes())
+
+	// bytes round-trip
+	sk1, err := BytesToBLSPrivateKey(sk.Bytes())
+	require.NoError(err)
+	require.Equal(sk, sk1)
+	pk1, err := BytesToPublicKey(pk.Bytes())
+	require.NoError(err)
+	require.Equal(pk, pk1)
+	_, err = BytesToBLSPrivateKey(make([]byte, BLSPrvKeySize))
+	require.Equal(ErrPrivateKey, err)
+	b := pk.Bytes()
+	b[BLSPubKeySize-1]++
+	_, err = BytesToPublicKey(b)
+	require.ErrorIs(err, ErrPublicKey)
+
+	// sign/verify
+	msg := []byte("test bls signature")
+	sig, err := sk.Sign(msg)
+	require.NoError(err)
+	require.Equal(BLSSigSize, len(sig))
+	require.True(pk.Verify(msg, sig))
+	require.False(pk.Verify(msg[1:], sig))
+	require.False(pk.Verify(msg, sig[1:]))
+	sig[BLSSigSize-1]++
+	require.False(pk.Verify(msg, sig))
+
+	// KeyGen test vector in EIP-2333
+	seed, _ := hex.DecodeString("c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04")
+	sk, err = newBLSPrvKeyFromIKM(seed)
+	require.NoError(err)
+	d, _ := new(big.Int).SetString("6083874454709270928345386274498605044986640685124978867557563392430687146096", 10)
+	require.Equal(d, sk.EcdsaPrivateKey())
+}
+
+func TestBLSAggregate(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		msg    = []byte("test bls aggregation")
+		pks    []PublicKey
+		sigs   [][]byte
+		proofs [][]byte
+	)
+	for i := 0; i < 5; i++ {
+		sk, err := GenerateKeyBLS()
+		require.NoError(err)
+		sig, err := sk.Sign(msg)
+		require.NoError(err)
+		proof, err := ProveBLSPossession(sk)
+		require.NoError(err)
+		pks = append(pks, sk.PublicKey())
+		sigs = append(sigs, sig)
+		proofs = append(proofs, proof)
+	}
+
+	for i := range pks {
+		require.True(VerifyBLSPossession(pks[i], proofs[i]))
+		require.False(VerifyBLSPossession(pks[i], proofs[(i+1)%len(pks)]))
+		// proof-of-possession is not a valid signature of public key, and vice versa
+		require.False(pks[i].Verify(pks[i].Bytes(), proofs[i]))
+	}
+
+	agg, err := AggregateBLSSignatures(sigs)
+	require.NoError(err)
+	require.Equal(BLSSigSize, len(agg))
+	require.True(FastAggregateVerifyBLS(pks, msg, agg))
+	require.False(FastAggregateVerifyBLS(pks[1:], msg, agg))
+	require.False(FastAggregateVerifyBLS(pks, msg[1:], agg))
+	require.False(FastAggregateVerifyBLS(nil, msg, agg))
+
+	aggPk, err := AggregateBLSPublicKeys(pks)
+	require.NoError(err)
+	require.True(aggPk.Verify(msg, agg))
+
+	// errors
+	_, err = AggregateBLSSignatures(nil)
+	require.Error(err)
+	_, err = AggregateBLSSignatures([][]byte{sigs[0], sigs[1][1:]})
+	require.Error(err)
+	sk, err := GenerateKey()
+	require.NoError(err)
+	_, err = AggregateBLSPublicKeys(append(pks, sk.PublicKey()))
+	require.ErrorIs(err, ErrPublicKey)
+	_, err = ProveBLSPossession(sk)
+	require.ErrorIs(err, ErrPrivateKey)
+	require.False(VerifyBLSPossession(sk.PublicKey(), proofs[0]))
+}
@@ -0,0 +1,308 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"hash"
+	"io"
+	"math/big"
+
+	"github.com/dustinxie/gmsm/sm2"
+	"github.com/dustinxie/gmsm/sm3"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// DefaultSharedKeyLen is the default length of derived shared key
+	DefaultSharedKeyLen = 32
+)
+
+var (
+	// ErrKeyMismatch indicates the private key and peer's public key are not on the same curve
+	ErrKeyMismatch = errors.New("private key and public key are not on the same curve")
+
+	// sm2DefaultUID is the default user identity per GM/T 0009
+	sm2DefaultUID = []byte("1234567812345678")
+)
+
+type (
+	// ECDHOption is an option of key agreement
+	ECDHOption func(*ecdhConfig)
+
+	ecdhConfig struct {
+		salt   []byte
+		info   []byte
+		keyLen int
+		uid    []byte
+		peerID []byte
+	}
+
+	// SM2KeyExchange is one party of the SM2 key exchange per GM/T 0003.3, which
+	// authenticates both parties by their static keys and agrees on the session
+	// key with ephemeral keys:
+	//
+	//  1. each party sends its EphemeralPublicKey to the other
+	//  2. each party calls ComputeKey with the peer's ephemeral public key
+	//  3. optionally, each party sends its Confirmation to the other, and checks
+	//     the peer's one with VerifyConfirmation
+	SM2KeyExchange struct {
+		initiator bool
+		keyLen    int
+		sk        *sm2.PrivateKey
+		peer      *sm2.PublicKey
+		eph       *sm2.PrivateKey
+		za, zb    []byte
+		// confirmation tags, available after ComputeKey
+		s2, s3 []byte
+	}
+)
+
+// ECDHSalt sets the salt of KDF
+func ECDHSalt(salt []byte) ECDHOption {
+	return func(cfg *ecdhConfig) {
+		cfg.salt = salt
+	}
+}
+
+// ECDHInfo sets the context info of KDF
+func ECDHInfo(info []byte) ECDHOption {
+	return func(cfg *ecdhConfig) {
+		cfg.info = info
+	}
+}
+
+// ECDHKeyLen sets the length of derived key, default to DefaultSharedKeyLen
+func ECDHKeyLen(n int) ECDHOption {
+	return func(cfg *ecdhConfig) {
+		if n > 0 {
+			cfg.keyLen = n
+		}
+	}
+}
+
+// SM2UserID sets the user identity of self and peer in SM2 key exchange, default
+// to "1234567812345678" for both
+func SM2UserID(uid, peerID []byte) ECDHOption {
+	return func(cfg *ecdhConfig) {
+		cfg.uid = uid
+		cfg.peerID = peerID
+	}
+}
+
+func newECDHConfig(opts []ECDHOption) *ecdhConfig {
+	cfg := &ecdhConfig{
+		keyLen: DefaultSharedKeyLen,
+		uid:    sm2DefaultUID,
+		peerID: sm2DefaultUID,
+	}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+	return cfg
+}
+
+// SharedSecret derives the shared secret of private key and peer's public key by
+// static ECDH, then runs it through HKDF with the salt and info in options, which
+// is HKDF-SHA256 for secp256k1 keys and HKDF-SM3 for P256sm2 keys
+func SharedSecret(sk PrivateKey, peer PublicKey, opts ...ECDHOption) ([]byte, error) {
+	var (
+		cfg     = newECDHConfig(opts)
+		secret  []byte
+		newHash func() hash.Hash
+	)
+	switch k := sk.(type) {
+	case *secp256k1PrvKey:
+		pk, ok := peer.(*secp256k1PubKey)
+		if !ok {
+			return nil, errors.Wrapf(ErrKeyMismatch, "secp256k1 private key and %T", peer)
+		}
+		ecdsaPK, err := pk.ecdsaPublicKey()
+		if err != nil {
+			return nil, err
+		}
+		secret = ecdhSecp256k1(k.PrivateKey, ecdsaPK)
+		newHash = sha256.New
+	case *P256sm2PrvKey:
+		pk, ok := peer.(*P256sm2PubKey)
+		if !ok {
+			return nil, errors.Wrapf(ErrKeyMismatch, "p256sm2 private key and %T", peer)
+		}
+		sm2PK, err := pk.sm2PublicKey()
+		if err != nil {
+			return nil, err
+		}
+		x, _ := sm2.P256Sm2().ScalarMult(sm2PK.X, sm2PK.Y, k.D())
+		secret = x.FillBytes(make([]byte, 32))
+		newHash = sm3.New
+	default:
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported private key type %T", sk)
+	}
+
+	key := make([]byte, cfg.keyLen)
+	if _, err := io.ReadFull(hkdf.New(newHash, secret, cfg.salt, cfg.info), key); err != nil {
+		return nil, err
+	}
+	return key, nil
+}
+
+// ecdhSecp256k1 returns the x-coordinate of sk * pk
+func ecdhSecp256k1(sk *ecdsa.PrivateKey, pk *ecdsa.PublicKey) []byte {
+	x, _ := crypto.S256().ScalarMult(pk.X, pk.Y, crypto.FromECDSA(sk))
+	return x.FillBytes(make([]byte, secp256k1PubKeyByteLen))
+}
+
+// NewSM2KeyExchange starts the SM2 key exchange with peer, initiator is the party A
+// in GM/T 0003.3, and the other party must be the responder. ECDHKeyLen and
+// SM2UserID apply to the key exchange, while salt and info do not as the KDF is
+// defined by the standard
+func NewSM2KeyExchange(sk PrivateKey, peer PublicKey, initiator bool, opts ...ECDHOption) (*SM2KeyExchange, error) {
+	k, ok := sk.(*P256sm2PrvKey)
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported private key type %T", sk)
+	}
+	pk, ok := peer.(*P256sm2PubKey)
+	if !ok {
+		return nil, errors.Wrapf(ErrKeyMismatch, "p256sm2 private key and %T", peer)
+	}
+	// the peer key is used in computeV for each exchange
+	if _, err := pk.sm2PublicKey(); err != nil {
+		return nil, err
+	}
+	cfg := newECDHConfig(opts)
+	zSelf, err := sm2.ZA(&k.PrivateKey.PublicKey, cfg.uid)
+	if err != nil {
+		return nil, err
+	}
+	zPeer, err := sm2.ZA(pk.PublicKey, cfg.peerID)
+	if err != nil {
+		return nil, err
+	}
+	eph, err := sm2.GenerateKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create ephemeral key")
+	}
+	kx := &SM2KeyExchange{
+		initiator: initiator,
+		keyLen:    cfg.keyLen,
+		sk:        k.PrivateKey,
+		peer:      pk.PublicKey,
+		eph:       eph,
+		za:        zSelf,
+		zb:        zPeer,
+	}
+	if !initiator {
+		kx.za, kx.zb = zPeer, zSelf
+	}
+	return kx, nil
+}
+
+// EphemeralPublicKey returns the ephemeral public key to send to the peer
+func (kx *SM2KeyExchange) EphemeralPublicKey() []byte {
+	return elliptic.Marshal(kx.eph.Curve, kx.eph.X, kx.eph.Y)
+}
+
+// ComputeKey computes the shared key with the peer's ephemeral public key
+func (kx *SM2KeyExchange) ComputeKey(peerEph []byte) ([]byte, error) {
+	xv, yv, err := kx.computeV(peerEph)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		ra = kx.EphemeralPublicKey()[1:]
+		rb = peerEph[1:]
+	)
+	if !kx.initiator {
+		ra, rb = rb, ra
+	}
+	key := sm3KDF(kx.keyLen, xv, yv, kx.za, kx.zb)
+
+	// S = Hash(tag || yV || Hash(xV || ZA || ZB || x1 || y1 || x2 || y2))
+	inner := sm3Sum(xv, kx.za, kx.zb, ra, rb)
+	kx.s2 = sm3Sum([]byte{0x02}, yv, inner)
+	kx.s3 = sm3Sum([]byte{0x03}, yv, inner)
+	return key, nil
+}
+
+// computeV returns the coordinates of V = t * (P' + x̄' * R'), where t = (d + x̄ * r)
+// mod n, the cofactor is 1
+func (kx *SM2KeyExchange) computeV(peerEph []byte) ([]byte, []byte, error) {
+	var (
+		curve = sm2.P256Sm2()
+		n     = curve.Params().N
+	)
+	px, py := elliptic.Unmarshal(curve, peerEph)
+	if px == nil {
+		return nil, nil, errors.Wrap(ErrPublicKey, "invalid ephemeral public key")
+	}
+	t := new(big.Int).Mul(sm2XBar(kx.eph.X), kx.eph.D)
+	t.Add(t, kx.sk.D)
+	t.Mod(t, n)
+	x, y := curve.ScalarMult(px, py, sm2XBar(px).FillBytes(make([]byte, 32)))
+	if x.Cmp(kx.peer.X) == 0 {
+		return nil, nil, errors.Wrap(ErrPublicKey, "invalid ephemeral public key")
+	}
+	x, y = curve.Add(kx.peer.X, kx.peer.Y, x, y)
+	vx, vy := curve.ScalarMult(x, y, t.FillBytes(make([]byte, 32)))
+	if vx.Sign() == 0 && vy.Sign() == 0 {
+		return nil, nil, errors.New("sm2 key exchange results in infinity")
+	}
+	return vx.FillBytes(make([]byte, 32)), vy.FillBytes(make([]byte, 32)), nil
+}
+
+// Confirmation returns the confirmation tag to send to the peer, which is SB for
+// responder and SA for initiator in GM/T 0003.3, it returns nil before ComputeKey
+func (kx *SM2KeyExchange) Confirmation() []byte {
+	if kx.initiator {
+		return kx.s3
+	}
+	return kx.s2
+}
+
+// VerifyConfirmation verifies the confirmation tag from the peer
+func (kx *SM2KeyExchange) VerifyConfirmation(tag []byte) bool {
+	expect := kx.s3
+	if kx.initiator {
+		expect = kx.s2
+	}
+	return expect != nil && subtle.ConstantTimeCompare(expect, tag) == 1
+}
+
+// sm2XBar returns 2^w + (x & (2^w - 1)), where w = 127
+func sm2XBar(x *big.Int) *big.Int {
+	w := new(big.Int).Lsh(big.NewInt(1), 127)
+	v := new(big.Int).Sub(w, big.NewInt(1))
+	v.And(v, x)
+	return v.Add(v, w)
+}
+
+// sm3KDF is the key derivation function per GM/T 0003.3
+func sm3KDF(keyLen int, z ...[]byte) []byte {
+	var (
+		key = make([]byte, 0, keyLen+sm3.New().Size())
+		ct  = make([]byte, 4)
+	)
+	for i := uint32(1); len(key) < keyLen; i++ {
+		binary.BigEndian.PutUint32(ct, i)
+		key = append(key, sm3Sum(append(z, ct)...)...)
+	}
+	return key[:keyLen]
+}
+
+func sm3Sum(data ...[]byte) []byte {
+	h := sm3.New()
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
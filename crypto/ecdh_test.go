@@ -0,0 +1,167 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/dustinxie/gmsm/sm2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestSharedSecret(t *testing.T) {
+	require := require.New(t)
+
+	for _, gen := range []func() (PrivateKey, error){GenerateKey, GenerateKeySm2} {
+		a, err := gen()
+		require.NoError(err)
+		b, err := gen()
+		require.NoError(err)
+
+		ka, err := SharedSecret(a, b.PublicKey())
+		require.NoError(err)
+		require.Equal(DefaultSharedKeyLen, len(ka))
+		kb, err := SharedSecret(b, a.PublicKey())
+		require.NoError(err)
+		require.Equal(ka, kb)
+
+		// salt and info
+		opts := []ECDHOption{ECDHSalt([]byte("salt")), ECDHInfo([]byte("p2p session")), ECDHKeyLen(64)}
+		ka1, err := SharedSecret(a, b.PublicKey(), opts...)
+		require.NoError(err)
+		require.Equal(64, len(ka1))
+		require.NotEqual(ka, ka1[:DefaultSharedKeyLen])
+		kb1, err := SharedSecret(b, a.PublicKey(), opts...)
+		require.NoError(err)
+		require.Equal(ka1, kb1)
+		kb1, err = SharedSecret(b, a.PublicKey(), ECDHInfo([]byte("p2p session")), ECDHKeyLen(64))
+		require.NoError(err)
+		require.NotEqual(ka1, kb1)
+
+		// different peer
+		c, err := gen()
+		require.NoError(err)
+		kc, err := SharedSecret(a, c.PublicKey())
+		require.NoError(err)
+		require.NotEqual(ka, kc)
+	}
+
+	// curve mismatch
+	a, err := GenerateKey()
+	require.NoError(err)
+	b, err := GenerateKeySm2()
+	require.NoError(err)
+	_, err = SharedSecret(a, b.PublicKey())
+	require.ErrorIs(err, ErrKeyMismatch)
+	_, err = SharedSecret(b, a.PublicKey())
+	require.ErrorIs(err, ErrKeyMismatch)
+	c, err := GenerateKeyEd25519()
+	require.NoError(err)
+	_, err = SharedSecret(a, c.PublicKey())
+	require.ErrorIs(err, ErrKeyMismatch)
+	_, err = SharedSecret(c, a.PublicKey())
+	require.ErrorIs(err, ErrInvalidKey)
+
+	// peer key off curve
+	_, err = BytesToPublicKey(offCurveSecp256k1Key())
+	require.ErrorIs(err, ErrPublicKey)
+	_, err = SharedSecret(a, &secp256k1PubKey{raw: offCurveSecp256k1Key()})
+	require.ErrorIs(err, ErrPublicKey)
+	_, err = BytesToPublicKey(offCurveP256sm2Key())
+	require.ErrorIs(err, ErrPublicKey)
+	_, err = SharedSecret(b, &P256sm2PubKey{&sm2.PublicKey{Curve: sm2.P256Sm2()}})
+	require.ErrorIs(err, ErrPublicKey)
+}
+
+// offCurveSecp256k1Key returns 0x04 || 0 || 7, which is not on the curve y^2 = x^3 + 7
+func offCurveSecp256k1Key() []byte {
+	b := make([]byte, secp256pubKeyLength)
+	b[0], b[64] = 4, 7
+	return b
+}
+
+// offCurveP256sm2Key returns the DER of a point not on the sm2 curve, whose y of
+// a valid point is changed
+func offCurveP256sm2Key() []byte {
+	sk, _ := GenerateKeySm2()
+	b := sk.PublicKey().Bytes()
+	b[len(b)-1] ^= 1
+	return b
+}
+
+func TestSM2KeyExchange(t *testing.T) {
+	require := require.New(t)
+
+	a, err := GenerateKeySm2()
+	require.NoError(err)
+	b, err := GenerateKeySm2()
+	require.NoError(err)
+	ida, idb := []byte("alice@iotex.io"), []byte("bob@iotex.io")
+
+	kxa, err := NewSM2KeyExchange(a, b.PublicKey(), true, SM2UserID(ida, idb), ECDHKeyLen(16))
+	require.NoError(err)
+	kxb, err := NewSM2KeyExchange(b, a.PublicKey(), false, SM2UserID(idb, ida), ECDHKeyLen(16))
+	require.NoError(err)
+	require.Nil(kxa.Confirmation())
+	require.False(kxb.VerifyConfirmation(nil))
+
+	kb, err := kxb.ComputeKey(kxa.EphemeralPublicKey())
+	require.NoError(err)
+	require.Equal(16, len(kb))
+	ka, err := kxa.ComputeKey(kxb.EphemeralPublicKey())
+	require.NoError(err)
+	require.Equal(ka, kb)
+	require.True(kxa.VerifyConfirmation(kxb.Confirmation()))
+	require.True(kxb.VerifyConfirmation(kxa.Confirmation()))
+	require.False(kxa.VerifyConfirmation(kxa.Confirmation()))
+
+	// same key as gmsm, whose confirmation tags hash RB before RA unlike the standard,
+	// and which does not pad V, so only compare when its coordinates are full-length
+	pa, pb := a.(*P256sm2PrvKey).PrivateKey, b.(*P256sm2PrvKey).PrivateKey
+	k, _, _, err := sm2.KeyExchangeA(16, ida, idb, pa, &pb.PublicKey, kxa.eph, &kxb.eph.PublicKey)
+	require.NoError(err)
+	xv, yv, err := kxa.computeV(kxb.EphemeralPublicKey())
+	require.NoError(err)
+	if xv[0] != 0 && yv[0] != 0 {
+		require.Equal(ka, k)
+	}
+
+	// mismatched user id
+	kxb, err = NewSM2KeyExchange(b, a.PublicKey(), false, SM2UserID(idb, idb), ECDHKeyLen(16))
+	require.NoError(err)
+	kb, err = kxb.ComputeKey(kxa.EphemeralPublicKey())
+	require.NoError(err)
+	ka, err = kxa.ComputeKey(kxb.EphemeralPublicKey())
+	require.NoError(err)
+	require.NotEqual(ka, kb)
+	require.False(kxa.VerifyConfirmation(kxb.Confirmation()))
+
+	// both are initiator
+	kxb, err = NewSM2KeyExchange(b, a.PublicKey(), true)
+	require.NoError(err)
+	kb, err = kxb.ComputeKey(kxa.EphemeralPublicKey())
+	require.NoError(err)
+	require.NotEqual(ka, kb)
+
+	// invalid ephemeral key
+	eph := kxb.EphemeralPublicKey()
+	eph[64]++
+	_, err = kxa.ComputeKey(eph)
+	require.ErrorIs(err, ErrPublicKey)
+	_, err = kxa.ComputeKey(eph[:33])
+	require.ErrorIs(err, ErrPublicKey)
+
+	// key type
+	c, err := GenerateKey()
+	require.NoError(err)
+	_, err = NewSM2KeyExchange(a, c.PublicKey(), true)
+	require.ErrorIs(err, ErrKeyMismatch)
+	_, err = NewSM2KeyExchange(c, a.PublicKey(), true)
+	require.ErrorIs(err, ErrInvalidKey)
+	_, err = NewSM2KeyExchange(a, &P256sm2PubKey{&sm2.PublicKey{Curve: sm2.P256Sm2()}}, true)
+	require.ErrorIs(err, ErrPublicKey)
+}
@@ -0,0 +1,209 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/hex"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+type (
+	// ed25519PrvKey implements the Ed25519 private key
+	ed25519PrvKey struct {
+		ed25519.PrivateKey
+	}
+	// ed25519PubKey implements the Ed25519 public key
+	ed25519PubKey struct {
+		ed25519.PublicKey
+	}
+)
+
+//======================================
+// PrivateKey function
+//======================================
+
+// newEd25519PrvKey generates a new Ed25519 private key
+func newEd25519PrvKey() (PrivateKey, error) {
+	_, sk, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create ed25519 private key")
+	}
+	return &ed25519PrvKey{
+		PrivateKey: sk,
+	}, nil
+}
+
+// newEd25519PrvKeyFromBytes converts the 64-byte (seed || public key) format to PrivateKey
+func newEd25519PrvKeyFromBytes(b []byte) (PrivateKey, error) {
+	if len(b) != ed25519.PrivateKeySize {
+		return nil, ErrPrivateKey
+	}
+	sk := ed25519.NewKeyFromSeed(b[:ed25519.SeedSize])
+	// the public key half must match the seed
+	if !bytes.Equal(sk[ed25519.SeedSize:], b[ed25519.SeedSize:]) {
+		return nil, ErrPrivateKey
+	}
+	return &ed25519PrvKey{
+		PrivateKey: sk,
+	}, nil
+}
+
+// Bytes returns the private key in 64-byte (seed || public key) format
+func (k *ed25519PrvKey) Bytes() []byte {
+	b := make([]byte, ed25519.PrivateKeySize)
+	copy(b, k.PrivateKey)
+	return b
+}
+
+// HexString returns the private key in hex string
+func (k *ed25519PrvKey) HexString() string {
+	return hex.EncodeToString(k.Bytes())
+}
+
+// EcdsaPrivateKey returns the embedded ed25519 private key
+func (k *ed25519PrvKey) EcdsaPrivateKey() interface{} {
+	return k.PrivateKey
+}
+
+// PublicKey returns the public key corresponding to private key
+func (k *ed25519PrvKey) PublicKey() PublicKey {
+	pk := make([]byte, ed25519.PublicKeySize)
+	copy(pk, k.PrivateKey[ed25519.SeedSize:])
+	return &ed25519PubKey{
+		PublicKey: pk,
+	}
+}
+
+// Sign signs the message/hash
+func (k *ed25519PrvKey) Sign(hash []byte) ([]byte, error) {
+	return ed25519.Sign(k.PrivateKey, hash), nil
+}
+
+// Zero zeroes the private key data
+func (k *ed25519PrvKey) Zero() {
+	for i := range k.PrivateKey {
+		k.PrivateKey[i] = 0
+	}
+}
+
+// KeyType returns the key type
+func (k *ed25519PrvKey) KeyType() KeyType {
+	return KeyTypeEd25519
+}
+
+// MarshalText encodes the private key to the hex string of tagged encoding
+func (k *ed25519PrvKey) MarshalText() ([]byte, error) {
+	return marshalPrivateKeyText(k)
+}
+
+// MarshalJSON encodes the private key to JSON string of MarshalText
+func (k *ed25519PrvKey) MarshalJSON() ([]byte, error) {
+	return marshalKeyJSON(k.MarshalText())
+}
+
+// UnmarshalText decodes the private key from the hex string of tagged encoding
+func (k *ed25519PrvKey) UnmarshalText(text []byte) error {
+	key, err := unmarshalPrivateKeyText(text, KeyTypeEd25519)
+	if err != nil {
+		return err
+	}
+	*k = *key.(*ed25519PrvKey)
+	return nil
+}
+
+//======================================
+// PublicKey function
+//======================================
+
+// newEd25519PubKeyFromBytes converts bytes format to PublicKey
+func newEd25519PubKeyFromBytes(b []byte) (PublicKey, error) {
+	if len(b) != ed25519.PublicKeySize {
+		return nil, ErrPublicKey
+	}
+	pk := make([]byte, ed25519.PublicKeySize)
+	copy(pk, b)
+	return &ed25519PubKey{
+		PublicKey: pk,
+	}, nil
+}
+
+// Bytes returns the public key in bytes representation
+func (k *ed25519PubKey) Bytes() []byte {
+	return k.PublicKey
+}
+
+// CompressedBytes returns the public key in bytes representation, which is already compressed
+func (k *ed25519PubKey) CompressedBytes() []byte {
+	return k.PublicKey
+}
+
+// HexString returns the public key in hex string
+func (k *ed25519PubKey) HexString() string {
+	return hex.EncodeToString(k.Bytes())
+}
+
+// EcdsaPublicKey returns the embedded ed25519 public key
+func (k *ed25519PubKey) EcdsaPublicKey() interface{} {
+	return k.PublicKey
+}
+
+// Hash is the last 20-byte of keccak hash of the 32-byte public key
+func (k *ed25519PubKey) Hash() []byte {
+	h := hash.Hash160b(k.Bytes())
+	return h[:]
+}
+
+// Verify verifies the signature
+func (k *ed25519PubKey) Verify(hash, sig []byte) bool {
+	if len(sig) != ed25519.SignatureSize {
+		return false
+	}
+	return ed25519.Verify(k.PublicKey, hash, sig)
+}
+
+// VerifySignature returns false, Signature does not hold Ed25519 signature
+func (k *ed25519PubKey) VerifySignature(hash []byte, sig *Signature) bool {
+	return false
+}
+
+// Address returns the address object
+func (k *ed25519PubKey) Address() address.Address {
+	addr, _ := address.FromBytes(k.Hash())
+	return addr
+}
+
+// KeyType returns the key type
+func (k *ed25519PubKey) KeyType() KeyType {
+	return KeyTypeEd25519
+}
+
+// MarshalText encodes the public key to the hex string of tagged encoding
+func (k *ed25519PubKey) MarshalText() ([]byte, error) {
+	return marshalPublicKeyText(k)
+}
+
+// MarshalJSON encodes the public key to JSON string of MarshalText
+func (k *ed25519PubKey) MarshalJSON() ([]byte, error) {
+	return marshalKeyJSON(k.MarshalText())
+}
+
+// UnmarshalText decodes the public key from the hex string of tagged encoding
+func (k *ed25519PubKey) UnmarshalText(text []byte) error {
+	key, err := unmarshalPublicKeyText(text, KeyTypeEd25519)
+	if err != nil {
+		return err
+	}
+	*k = *key.(*ed25519PubKey)
+	return nil
+}
@@ -0,0 +1,70 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+func TestEd25519(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := GenerateKeyEd25519()
+	require.NoError(err)
+	require.Equal(ed25519.PrivateKeySize, len(sk.Bytes()))
+	pk := sk.PublicKey()
+	require.Equal(ed25519.PublicKeySize, len(pk.Bytes()))
+	require.Equal(pk.Bytes(), pk.CompressedBytes())
+	require.Equal(20, len(pk.Hash()))
+	require.Equal(pk.Hash(), pk.Address().Bytes())
+	_, ok := sk.EcdsaPrivateKey().(ed25519.PrivateKey)
+	require.True(ok)
+	_, ok = pk.EcdsaPublicKey().(ed25519.PublicKey)
+	require.True(ok)
+
+	// bytes round-trip, and telling apart from other key types
+	sk1, err := BytesToPrivateKey(sk.Bytes())
+	require.NoError(err)
+	require.Equal(sk, sk1)
+	pk1, err := BytesToPublicKey(pk.Bytes())
+	require.NoError(err)
+	require.Equal(pk, pk1)
+	b := sk.Bytes()
+	b[40]++
+	_, err = BytesToPrivateKey(b)
+	require.Equal(ErrPrivateKey, err)
+
+	// sign/verify
+	h := hash.Hash256b([]byte("test ed25519 signature"))
+	sig, err := sk.Sign(h[:])
+	require.NoError(err)
+	require.Equal(ed25519.SignatureSize, len(sig))
+	require.True(pk.Verify(h[:], sig))
+	sig[0]++
+	require.False(pk.Verify(h[:], sig))
+	require.False(pk.Verify(h[:], sig[1:]))
+
+	// not recoverable
+	_, err = RecoverPubkey(h[:], sig)
+	require.Equal(ErrInvalidKey, err)
+
+	// RFC 8032 test vector 1
+	sk, err = HexStringToPrivateKey("9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a")
+	require.NoError(err)
+	sig, err = sk.Sign(nil)
+	require.NoError(err)
+	require.Equal("e5564300c360ac729086e2cc806e828a84877f1eb8e5d974d873e065224901555fb8821590a33bacc61e39701cf9b46bd25bf5f0595bbe24655141438e7a100b", hex.EncodeToString(sig))
+
+	sk.Zero()
+	require.Equal(make([]byte, ed25519.PrivateKeySize), sk.Bytes())
+}
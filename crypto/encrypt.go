@@ -0,0 +1,192 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+
+	"github.com/dustinxie/gmsm/sm2"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/hkdf"
+)
+
+// the first byte of ciphertext is the version, which determines the scheme and format
+const (
+	// CiphertextVersionECIES is ECIES on secp256k1, the ciphertext is
+	// version (1) || ephemeral public key (33) || nonce (12) || AES-256-GCM sealed message,
+	// where the AES key is derived by HKDF-SHA256 from the ECDH shared secret
+	CiphertextVersionECIES byte = 0x01
+	// CiphertextVersionSM2 is SM2 encryption per GM/T 0003.4, the ciphertext is
+	// version (1) || C1 (65) || C3 (32) || C2
+	CiphertextVersionSM2 byte = 0x02
+)
+
+const (
+	eciesKeySize   = 32
+	eciesNonceSize = 12
+	sm2C1Size      = 65
+	sm2C3Size      = 32
+)
+
+var (
+	// ErrCiphertext indicates the ciphertext is invalid
+	ErrCiphertext = errors.New("invalid ciphertext")
+
+	eciesInfo = []byte("IOTEX-ECIES-SECP256K1-HKDF-SHA256-AES256GCM")
+)
+
+// Encrypt encrypts the plaintext to the owner of public key, secp256k1 key uses
+// ECIES and P256sm2 key uses SM2 encryption
+func Encrypt(pk PublicKey, plaintext []byte) ([]byte, error) {
+	switch k := pk.(type) {
+	case *secp256k1PubKey:
+		return eciesEncrypt(k, plaintext)
+	case *P256sm2PubKey:
+		return sm2Encrypt(k, plaintext)
+	default:
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported public key type %T", pk)
+	}
+}
+
+// Decrypt decrypts the ciphertext produced by Encrypt with the private key
+func Decrypt(sk PrivateKey, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) == 0 {
+		return nil, ErrCiphertext
+	}
+	version := ciphertext[0]
+	switch k := sk.(type) {
+	case *secp256k1PrvKey:
+		if version != CiphertextVersionECIES {
+			return nil, errors.Wrapf(ErrCiphertext, "unsupported version %d for secp256k1 key", version)
+		}
+		return eciesDecrypt(k, ciphertext[1:])
+	case *P256sm2PrvKey:
+		if version != CiphertextVersionSM2 {
+			return nil, errors.Wrapf(ErrCiphertext, "unsupported version %d for p256sm2 key", version)
+		}
+		return sm2Decrypt(k, ciphertext[1:])
+	default:
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported private key type %T", sk)
+	}
+}
+
+//======================================
+// ECIES on secp256k1
+//======================================
+
+func eciesEncrypt(pk *secp256k1PubKey, plaintext []byte) ([]byte, error) {
+	eph, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create ephemeral key")
+	}
+	defer (&secp256k1PrvKey{eph}).Zero()
+	ephPub := crypto.CompressPubkey(&eph.PublicKey)
+	ecdsaPK, err := pk.ecdsaPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	aead, err := eciesAEAD(ecdhSecp256k1(eph, ecdsaPK), ephPub, pk.CompressedBytes())
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, eciesNonceSize)
+	if _, err := io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, errors.Wrap(err, "failed to generate nonce")
+	}
+
+	// the header is authenticated as additional data
+	out := make([]byte, 0, 1+len(ephPub)+eciesNonceSize+len(plaintext)+aead.Overhead())
+	out = append(out, CiphertextVersionECIES)
+	out = append(out, ephPub...)
+	header := out
+	out = append(out, nonce...)
+	return aead.Seal(out, nonce, plaintext, header), nil
+}
+
+func eciesDecrypt(sk *secp256k1PrvKey, data []byte) ([]byte, error) {
+	if len(data) < secp256pubKeyCompressedLength+eciesNonceSize+16 {
+		return nil, errors.Wrap(ErrCiphertext, "ciphertext too short")
+	}
+	ephPub := data[:secp256pubKeyCompressedLength]
+	eph, err := crypto.DecompressPubkey(ephPub)
+	if err != nil {
+		return nil, errors.Wrap(ErrCiphertext, "invalid ephemeral public key")
+	}
+	aead, err := eciesAEAD(ecdhSecp256k1(sk.PrivateKey, eph), ephPub, sk.PublicKey().CompressedBytes())
+	if err != nil {
+		return nil, err
+	}
+	var (
+		header = append([]byte{CiphertextVersionECIES}, ephPub...)
+		nonce  = data[secp256pubKeyCompressedLength : secp256pubKeyCompressedLength+eciesNonceSize]
+	)
+	plaintext, err := aead.Open(nil, nonce, data[secp256pubKeyCompressedLength+eciesNonceSize:], header)
+	if err != nil {
+		return nil, errors.Wrap(ErrCiphertext, err.Error())
+	}
+	return plaintext, nil
+}
+
+// eciesAEAD derives the AES-256-GCM cipher from shared secret, bound to both the
+// ephemeral and recipient public keys
+func eciesAEAD(secret, ephPub, recipientPub []byte) (cipher.AEAD, error) {
+	info := make([]byte, 0, len(eciesInfo)+len(ephPub)+len(recipientPub))
+	info = append(info, eciesInfo...)
+	info = append(info, ephPub...)
+	info = append(info, recipientPub...)
+	key := make([]byte, eciesKeySize)
+	if _, err := io.ReadFull(hkdf.New(sha256.New, secret, nil, info), key); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+//======================================
+// SM2 encryption per GM/T 0003.4
+//======================================
+
+func sm2Encrypt(pk *P256sm2PubKey, plaintext []byte) ([]byte, error) {
+	// sm2.Encrypt never returns for empty message, whose KDF output is regarded as all-zero
+	if len(plaintext) == 0 {
+		return nil, errors.New("cannot encrypt empty message with sm2")
+	}
+	sm2PK, err := pk.sm2PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	c, err := sm2.Encrypt(sm2PK, plaintext)
+	if err != nil {
+		return nil, err
+	}
+	// c is C1 || C3 || C2, with C1 in uncompressed form
+	return append([]byte{CiphertextVersionSM2}, c...), nil
+}
+
+func sm2Decrypt(sk *P256sm2PrvKey, data []byte) ([]byte, error) {
+	if len(data) <= sm2C1Size+sm2C3Size {
+		return nil, errors.Wrap(ErrCiphertext, "ciphertext too short")
+	}
+	// C1 must be on curve, otherwise the decryption may leak information of private key
+	if x, _ := elliptic.Unmarshal(sm2.P256Sm2(), data[:sm2C1Size]); x == nil {
+		return nil, errors.Wrap(ErrCiphertext, "invalid C1")
+	}
+	plaintext, err := sm2.Decrypt(sk.PrivateKey, data)
+	if err != nil {
+		return nil, errors.Wrap(ErrCiphertext, err.Error())
+	}
+	return plaintext, nil
+}
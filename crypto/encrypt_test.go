@@ -0,0 +1,95 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/dustinxie/gmsm/sm2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestEncrypt(t *testing.T) {
+	require := require.New(t)
+
+	for _, v := range []struct {
+		gen     func() (PrivateKey, error)
+		version byte
+	}{
+		{GenerateKey, CiphertextVersionECIES},
+		{GenerateKeySm2, CiphertextVersionSM2},
+	} {
+		sk, err := v.gen()
+		require.NoError(err)
+		pk := sk.PublicKey()
+		msg := []byte("encrypted memo")
+
+		c, err := Encrypt(pk, msg)
+		require.NoError(err)
+		require.Equal(v.version, c[0])
+		c1, err := Encrypt(pk, msg)
+		require.NoError(err)
+		require.NotEqual(c, c1)
+		for _, ct := range [][]byte{c, c1} {
+			b, err := Decrypt(sk, ct)
+			require.NoError(err)
+			require.Equal(msg, b)
+		}
+
+		// wrong key
+		sk1, err := v.gen()
+		require.NoError(err)
+		_, err = Decrypt(sk1, c)
+		require.ErrorIs(err, ErrCiphertext)
+
+		// tampered ciphertext
+		for _, i := range []int{1, len(c) / 2, len(c) - 1} {
+			b := make([]byte, len(c))
+			copy(b, c)
+			b[i] ^= 1
+			_, err = Decrypt(sk, b)
+			require.ErrorIs(err, ErrCiphertext)
+		}
+		_, err = Decrypt(sk, c[:len(c)/2])
+		require.ErrorIs(err, ErrCiphertext)
+		_, err = Decrypt(sk, nil)
+		require.ErrorIs(err, ErrCiphertext)
+	}
+
+	// ciphertext version does not match the key
+	sk, err := GenerateKey()
+	require.NoError(err)
+	sk1, err := GenerateKeySm2()
+	require.NoError(err)
+	c, err := Encrypt(sk1.PublicKey(), []byte{1})
+	require.NoError(err)
+	_, err = Decrypt(sk, c)
+	require.ErrorIs(err, ErrCiphertext)
+	c, err = Encrypt(sk.PublicKey(), nil)
+	require.NoError(err)
+	b, err := Decrypt(sk, c)
+	require.NoError(err)
+	require.Empty(b)
+	_, err = Decrypt(sk1, c)
+	require.ErrorIs(err, ErrCiphertext)
+	_, err = Encrypt(sk1.PublicKey(), nil)
+	require.Error(err)
+
+	// unsupported key type
+	sk2, err := GenerateKeyEd25519()
+	require.NoError(err)
+	_, err = Encrypt(sk2.PublicKey(), []byte{1})
+	require.ErrorIs(err, ErrInvalidKey)
+	_, err = Decrypt(sk2, c)
+	require.ErrorIs(err, ErrInvalidKey)
+
+	// public key off curve
+	_, err = Encrypt(&secp256k1PubKey{raw: offCurveSecp256k1Key()}, []byte{1})
+	require.ErrorIs(err, ErrPublicKey)
+	_, err = Encrypt(&P256sm2PubKey{&sm2.PublicKey{Curve: sm2.P256Sm2()}}, []byte{1})
+	require.ErrorIs(err, ErrPublicKey)
+}
@@ -0,0 +1,159 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"log"
+	"runtime"
+	"sync"
+
+	"github.com/pkg/errors"
+)
+
+// ErrKeyClosed indicates the guarded key has been closed
+var ErrKeyClosed = errors.New("guarded key is closed")
+
+// GuardedKey holds the secret of secp256k1 or P256sm2 private key in locked memory,
+// which is not swapped to disk (on Linux) and is zeroed on Close
+//
+// the secret is never returned, it is accessed in a callback by Use or UseBytes
+// instead. A GuardedKey that is garbage collected without Close is reported to the
+// standard logger
+//
+// only UseBytes keeps the secret in locked memory, Use and Sign rebuild the private
+// key on Go heap for each call, see Use for what is left there
+//
+// on Linux each GuardedKey takes one page of locked memory, which is counted against
+// RLIMIT_MEMLOCK of the process, NewGuardedKey fails once the limit is reached
+type GuardedKey struct {
+	mu      sync.RWMutex
+	secret  []byte
+	keyType KeyType
+	pk      PublicKey
+}
+
+// NewGuardedKey moves the secret of private key into locked memory, the private key
+// passed in is zeroed and must not be used afterwards
+func NewGuardedKey(sk PrivateKey) (*GuardedKey, error) {
+	var d []byte
+	switch k := sk.(type) {
+	case *secp256k1PrvKey:
+		d = k.Bytes()
+	case *P256sm2PrvKey:
+		d = k.D()
+	default:
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported private key type %T", sk)
+	}
+	defer zeroBytes(d)
+
+	secret, err := allocLocked(len(d))
+	if err != nil {
+		return nil, err
+	}
+	copy(secret, d)
+	g := &GuardedKey{
+		secret:  secret,
+		keyType: sk.KeyType(),
+		pk:      sk.PublicKey(),
+	}
+	sk.Zero()
+	runtime.SetFinalizer(g, func(g *GuardedKey) {
+		if g.secret != nil {
+			log.Printf("crypto: guarded %s key %x is garbage collected without Close", g.keyType, g.pk.Hash())
+			g.Close()
+		}
+	})
+	return g, nil
+}
+
+// KeyType returns the key type
+func (g *GuardedKey) KeyType() KeyType {
+	return g.keyType
+}
+
+// PublicKey returns the public key
+func (g *GuardedKey) PublicKey() PublicKey {
+	return g.pk
+}
+
+// Use calls f with the private key, which is zeroed after f returns, so f must
+// not keep the key
+//
+// the private key is rebuilt on Go heap and is not locked, it may be swapped to disk
+// while f runs. Zero only clears the words of scalar D, the copies made by the
+// underlying library (e.g. intermediate big.Int values during signing) are not
+// zeroed and stay on heap until garbage collected
+//
+// f runs on the rebuilt key without holding the lock, so it may call Close
+func (g *GuardedKey) Use(f func(PrivateKey) error) error {
+	sk, err := g.privateKey()
+	if err != nil {
+		return err
+	}
+	defer sk.Zero()
+	return f(sk)
+}
+
+// privateKey rebuilds the private key from the secret
+func (g *GuardedKey) privateKey() (PrivateKey, error) {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if g.secret == nil {
+		return nil, ErrKeyClosed
+	}
+	if g.keyType == KeyTypeSecp256k1 {
+		return newSecp256k1PrvKeyFromBytes(g.secret)
+	}
+	return newP256sm2PrvKeyFromD(g.secret)
+}
+
+// UseBytes calls f with the 32-byte secret in locked memory, f must not modify or
+// keep it
+//
+// the lock is held while f runs to keep the secret from being freed, so f must not
+// call Close of the same key, which deadlocks
+func (g *GuardedKey) UseBytes(f func([]byte) error) error {
+	g.mu.RLock()
+	defer g.mu.RUnlock()
+	if g.secret == nil {
+		return ErrKeyClosed
+	}
+	return f(g.secret)
+}
+
+// Sign signs the hash, same as PrivateKey.Sign
+//
+// it signs with the private key rebuilt by Use, so the same heap copies are left,
+// use UseBytes with a signer working on the raw secret if this is not acceptable
+func (g *GuardedKey) Sign(hash []byte) ([]byte, error) {
+	var sig []byte
+	err := g.Use(func(sk PrivateKey) error {
+		var err error
+		sig, err = sk.Sign(hash)
+		return err
+	})
+	return sig, err
+}
+
+// Close zeroes and releases the secret, it is safe to call Close more than once
+func (g *GuardedKey) Close() error {
+	g.mu.Lock()
+	defer g.mu.Unlock()
+	if g.secret == nil {
+		return nil
+	}
+	err := freeLocked(g.secret)
+	g.secret = nil
+	runtime.SetFinalizer(g, nil)
+	return err
+}
+
+func zeroBytes(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
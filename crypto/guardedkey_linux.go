@@ -0,0 +1,40 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+//go:build linux
+
+package crypto
+
+import (
+	"github.com/pkg/errors"
+	"golang.org/x/sys/unix"
+)
+
+// allocLocked allocates n bytes outside of Go heap, which is locked in RAM and
+// excluded from core dump
+//
+// mmap rounds n up to whole pages, so each call locks at least one page
+func allocLocked(n int) ([]byte, error) {
+	b, err := unix.Mmap(-1, 0, n, unix.PROT_READ|unix.PROT_WRITE, unix.MAP_PRIVATE|unix.MAP_ANONYMOUS)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to allocate memory")
+	}
+	if err := unix.Mlock(b); err != nil {
+		unix.Munmap(b)
+		return nil, errors.Wrap(err, "failed to lock memory, check RLIMIT_MEMLOCK")
+	}
+	// best effort, not supported by old kernels
+	_ = unix.Madvise(b, unix.MADV_DONTDUMP)
+	return b, nil
+}
+
+func freeLocked(b []byte) error {
+	zeroBytes(b)
+	if err := unix.Munlock(b); err != nil {
+		return err
+	}
+	return unix.Munmap(b)
+}
@@ -0,0 +1,19 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+//go:build !linux
+
+package crypto
+
+// allocLocked allocates n bytes on Go heap, memory locking is only supported on Linux
+func allocLocked(n int) ([]byte, error) {
+	return make([]byte, n), nil
+}
+
+func freeLocked(b []byte) error {
+	zeroBytes(b)
+	return nil
+}
@@ -0,0 +1,85 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+func TestGuardedKey(t *testing.T) {
+	require := require.New(t)
+
+	h := hash.Hash256b([]byte("guarded key"))
+	for _, gen := range []func() (PrivateKey, error){GenerateKey, GenerateKeySm2} {
+		sk, err := gen()
+		require.NoError(err)
+		pk := sk.PublicKey()
+		enc, err := EncodePrivateKey(sk)
+		require.NoError(err)
+
+		g, err := NewGuardedKey(sk)
+		require.NoError(err)
+		require.Equal(sk.KeyType(), g.KeyType())
+		require.Equal(pk.Bytes(), g.PublicKey().Bytes())
+		// the source key is zeroed
+		var d *big.Int
+		switch k := sk.(type) {
+		case *secp256k1PrvKey:
+			d = k.D
+		case *P256sm2PrvKey:
+			d = k.PrivateKey.D
+		}
+		for _, w := range d.Bits() {
+			require.Zero(w)
+		}
+
+		// signature is same as the private key
+		sig, err := g.Sign(h[:])
+		require.NoError(err)
+		require.True(pk.Verify(h[:], sig))
+
+		require.NoError(g.Use(func(k PrivateKey) error {
+			b, err := EncodePrivateKey(k)
+			require.NoError(err)
+			require.Equal(enc, b)
+			return nil
+		}))
+		require.NoError(g.UseBytes(func(b []byte) error {
+			require.True(bytes.HasSuffix(enc, b))
+			return nil
+		}))
+		errUse := errors.New("use")
+		require.Equal(errUse, g.Use(func(PrivateKey) error { return errUse }))
+
+		// closed in Use, the rebuilt key is still usable
+		require.NoError(g.Use(func(k PrivateKey) error {
+			require.NoError(g.Close())
+			sig, err := k.Sign(h[:])
+			require.NoError(err)
+			require.True(pk.Verify(h[:], sig))
+			return nil
+		}))
+		require.NoError(g.Close())
+		_, err = g.Sign(h[:])
+		require.Equal(ErrKeyClosed, err)
+		require.Equal(ErrKeyClosed, g.Use(func(PrivateKey) error { return nil }))
+		require.Equal(ErrKeyClosed, g.UseBytes(func([]byte) error { return nil }))
+	}
+
+	// unsupported key type
+	sk, err := GenerateKeyEd25519()
+	require.NoError(err)
+	_, err = NewGuardedKey(sk)
+	require.ErrorIs(err, ErrInvalidKey)
+}
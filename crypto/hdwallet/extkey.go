@@ -0,0 +1,293 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package hdwallet
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/hmac"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/binary"
+	"math/big"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/ripemd160"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+)
+
+const (
+	// serialized extended key is version(4) || depth(1) || fingerprint(4) || child number(4) || chain code(32) || key(33)
+	extendedKeyLength = 78
+	prvKeyLength      = 32
+)
+
+var (
+	// ErrInvalidSeed indicates the seed is invalid
+	ErrInvalidSeed = errors.New("invalid seed")
+	// ErrInvalidChild indicates the derived child key is invalid, the next index should be used instead
+	ErrInvalidChild = errors.New("invalid child key")
+	// ErrDeriveHardenedFromPublic indicates a hardened child cannot be derived from public key
+	ErrDeriveHardenedFromPublic = errors.New("cannot derive hardened child from public key")
+	// ErrNotPrivate indicates the extended key does not contain private key
+	ErrNotPrivate = errors.New("extended key is not private")
+	// ErrInvalidExtendedKey indicates the serialized extended key is invalid
+	ErrInvalidExtendedKey = errors.New("invalid extended key")
+
+	masterKeySeed = []byte("Bitcoin seed")
+
+	// version bytes of mainnet xprv and xpub
+	xprvVersion = []byte{0x04, 0x88, 0xad, 0xe4}
+	xpubVersion = []byte{0x04, 0x88, 0xb2, 0x1e}
+
+	curveN = ethcrypto.S256().Params().N
+)
+
+// ExtendedKey is a BIP-32 extended private or public key
+type ExtendedKey struct {
+	key       []byte // 32-byte private key, or 33-byte compressed public key
+	chainCode []byte
+	parentFP  []byte
+	depth     uint8
+	childNum  uint32
+	isPrivate bool
+}
+
+// NewMaster creates the master extended key from seed
+func NewMaster(seed []byte) (*ExtendedKey, error) {
+	if len(seed) < 16 || len(seed) > 64 {
+		return nil, errors.Wrapf(ErrInvalidSeed, "seed length %d not in [16, 64]", len(seed))
+	}
+	mac := hmac.New(sha512.New, masterKeySeed)
+	mac.Write(seed)
+	lr := mac.Sum(nil)
+
+	k := new(big.Int).SetBytes(lr[:32])
+	if k.Sign() == 0 || k.Cmp(curveN) >= 0 {
+		return nil, ErrInvalidSeed
+	}
+	return &ExtendedKey{
+		key:       lr[:32],
+		chainCode: lr[32:],
+		parentFP:  []byte{0, 0, 0, 0},
+		isPrivate: true,
+	}, nil
+}
+
+// NewMasterFromMnemonic creates the master extended key from BIP-39 mnemonic and passphrase
+func NewMasterFromMnemonic(mnemonic, passphrase string) (*ExtendedKey, error) {
+	seed, err := NewSeed(mnemonic, passphrase)
+	if err != nil {
+		return nil, err
+	}
+	return NewMaster(seed)
+}
+
+// IsPrivate returns true if the extended key contains private key
+func (k *ExtendedKey) IsPrivate() bool {
+	return k.isPrivate
+}
+
+// Depth returns the depth of the key, 0 being the master key
+func (k *ExtendedKey) Depth() uint8 {
+	return k.depth
+}
+
+// ChildNumber returns the index of the key within its parent
+func (k *ExtendedKey) ChildNumber() uint32 {
+	return k.childNum
+}
+
+// Child derives the child extended key at index i, indices no less than
+// HardenedKeyStart derive hardened child which requires private key
+func (k *ExtendedKey) Child(i uint32) (*ExtendedKey, error) {
+	if k.depth == 0xff {
+		return nil, errors.New("depth exceeds the maximum of 255")
+	}
+	hardened := i >= HardenedKeyStart
+	if hardened && !k.isPrivate {
+		return nil, ErrDeriveHardenedFromPublic
+	}
+
+	pub := k.pubKeyBytes()
+	data := make([]byte, 0, 37)
+	if hardened {
+		data = append(data, 0)
+		data = append(data, k.key...)
+	} else {
+		data = append(data, pub...)
+	}
+	data = binary.BigEndian.AppendUint32(data, i)
+	mac := hmac.New(sha512.New, k.chainCode)
+	mac.Write(data)
+	lr := mac.Sum(nil)
+
+	il := new(big.Int).SetBytes(lr[:32])
+	if il.Cmp(curveN) >= 0 {
+		return nil, ErrInvalidChild
+	}
+
+	var childKey []byte
+	if k.isPrivate {
+		// child = IL + kpar (mod N)
+		il.Add(il, new(big.Int).SetBytes(k.key))
+		il.Mod(il, curveN)
+		if il.Sign() == 0 {
+			return nil, ErrInvalidChild
+		}
+		childKey = make([]byte, prvKeyLength)
+		il.FillBytes(childKey)
+	} else {
+		// child = IL*G + Kpar
+		pk, err := ethcrypto.DecompressPubkey(k.key)
+		if err != nil {
+			return nil, errors.Wrap(ErrInvalidExtendedKey, err.Error())
+		}
+		curve := ethcrypto.S256()
+		x, y := curve.ScalarBaseMult(lr[:32])
+		x, y = curve.Add(x, y, pk.X, pk.Y)
+		if x.Sign() == 0 && y.Sign() == 0 {
+			return nil, ErrInvalidChild
+		}
+		childKey = ethcrypto.CompressPubkey(&ecdsa.PublicKey{Curve: curve, X: x, Y: y})
+	}
+	return &ExtendedKey{
+		key:       childKey,
+		chainCode: lr[32:],
+		parentFP:  hash160(pub)[:4],
+		depth:     k.depth + 1,
+		childNum:  i,
+		isPrivate: k.isPrivate,
+	}, nil
+}
+
+// Derive derives the extended key along the path, relative to this key
+func (k *ExtendedKey) Derive(path DerivationPath) (*ExtendedKey, error) {
+	var err error
+	key := k
+	for _, i := range path {
+		if key, err = key.Child(i); err != nil {
+			return nil, err
+		}
+	}
+	return key, nil
+}
+
+// Neuter returns the extended public key, which can derive non-hardened
+// child public keys for watch-only wallet
+func (k *ExtendedKey) Neuter() *ExtendedKey {
+	if !k.isPrivate {
+		return k
+	}
+	return &ExtendedKey{
+		key:       k.pubKeyBytes(),
+		chainCode: k.chainCode,
+		parentFP:  k.parentFP,
+		depth:     k.depth,
+		childNum:  k.childNum,
+		isPrivate: false,
+	}
+}
+
+// PrivateKey returns the secp256k1 private key
+func (k *ExtendedKey) PrivateKey() (crypto.PrivateKey, error) {
+	if !k.isPrivate {
+		return nil, ErrNotPrivate
+	}
+	return crypto.BytesToPrivateKey(k.key)
+}
+
+// PublicKey returns the secp256k1 public key
+func (k *ExtendedKey) PublicKey() (crypto.PublicKey, error) {
+	return crypto.BytesToPublicKey(k.pubKeyBytes())
+}
+
+// String returns the extended key serialized in base58 (xprv or xpub)
+func (k *ExtendedKey) String() string {
+	b := make([]byte, 0, extendedKeyLength+4)
+	if k.isPrivate {
+		b = append(b, xprvVersion...)
+	} else {
+		b = append(b, xpubVersion...)
+	}
+	b = append(b, k.depth)
+	b = append(b, k.parentFP...)
+	b = binary.BigEndian.AppendUint32(b, k.childNum)
+	b = append(b, k.chainCode...)
+	if k.isPrivate {
+		b = append(b, 0)
+	}
+	b = append(b, k.key...)
+	b = append(b, checksum(b)...)
+	return base58Encode(b)
+}
+
+// ParseExtendedKey parses a base58 serialized extended key (xprv or xpub)
+func ParseExtendedKey(s string) (*ExtendedKey, error) {
+	b, err := base58Decode(s)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidExtendedKey, err.Error())
+	}
+	if len(b) != extendedKeyLength+4 {
+		return nil, errors.Wrapf(ErrInvalidExtendedKey, "invalid length %d", len(b))
+	}
+	payload := b[:extendedKeyLength]
+	if !bytes.Equal(checksum(payload), b[extendedKeyLength:]) {
+		return nil, errors.Wrap(ErrInvalidExtendedKey, "checksum mismatch")
+	}
+
+	k := &ExtendedKey{
+		depth:     payload[4],
+		parentFP:  payload[5:9],
+		childNum:  binary.BigEndian.Uint32(payload[9:13]),
+		chainCode: payload[13:45],
+	}
+	key := payload[45:]
+	switch {
+	case bytes.Equal(payload[:4], xprvVersion):
+		if key[0] != 0 {
+			return nil, errors.Wrap(ErrInvalidExtendedKey, "invalid private key prefix")
+		}
+		d := new(big.Int).SetBytes(key[1:])
+		if d.Sign() == 0 || d.Cmp(curveN) >= 0 {
+			return nil, errors.Wrap(ErrInvalidExtendedKey, "private key out of range")
+		}
+		k.key = key[1:]
+		k.isPrivate = true
+	case bytes.Equal(payload[:4], xpubVersion):
+		if _, err := ethcrypto.DecompressPubkey(key); err != nil {
+			return nil, errors.Wrap(ErrInvalidExtendedKey, err.Error())
+		}
+		k.key = key
+	default:
+		return nil, errors.Wrap(ErrInvalidExtendedKey, "unknown version")
+	}
+	return k, nil
+}
+
+func (k *ExtendedKey) pubKeyBytes() []byte {
+	if !k.isPrivate {
+		return k.key
+	}
+	x, y := ethcrypto.S256().ScalarBaseMult(k.key)
+	return ethcrypto.CompressPubkey(&ecdsa.PublicKey{Curve: ethcrypto.S256(), X: x, Y: y})
+}
+
+func hash160(b []byte) []byte {
+	h := sha256.Sum256(b)
+	r := ripemd160.New()
+	r.Write(h[:])
+	return r.Sum(nil)
+}
+
+func checksum(b []byte) []byte {
+	h := sha256.Sum256(b)
+	h = sha256.Sum256(h[:])
+	return h[:4]
+}
@@ -0,0 +1,138 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package hdwallet
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testMnemonic = "abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon abandon about"
+
+func TestMnemonic(t *testing.T) {
+	require := require.New(t)
+
+	for _, size := range []int{MnemonicEntropy128, MnemonicEntropy256} {
+		m, err := NewMnemonic(size)
+		require.NoError(err)
+		require.Equal(size/32*3, len(strings.Fields(m)))
+		require.NoError(ValidateMnemonic(m))
+	}
+	_, err := NewMnemonic(100)
+	require.Error(err)
+
+	require.NoError(ValidateMnemonic(testMnemonic))
+	require.ErrorIs(ValidateMnemonic(strings.Replace(testMnemonic, "about", "abandon", 1)), ErrInvalidMnemonic)
+	require.ErrorIs(ValidateMnemonic("abandon foo"), ErrInvalidMnemonic)
+
+	// BIP-39 test vector
+	seed, err := NewSeed(testMnemonic, "TREZOR")
+	require.NoError(err)
+	require.Equal("c55257c360c07c72029aebc1b53c05ed0362ada38ead3e3e9efa3708e53495531f09a6987599d18264c1e1c92f2cf141630c7a3c4ab7c81b2f001698e7463b04", hex.EncodeToString(seed))
+	seed2, err := NewSeed(testMnemonic, "")
+	require.NoError(err)
+	require.NotEqual(seed, seed2)
+}
+
+func TestPath(t *testing.T) {
+	require := require.New(t)
+
+	tests := []struct {
+		path string
+		dp   DerivationPath
+	}{
+		{"m", nil},
+		{"m/0", DerivationPath{0}},
+		{"m/44'/304'/0'/0/7", DerivationPath{44 + HardenedKeyStart, 304 + HardenedKeyStart, HardenedKeyStart, 0, 7}},
+		{"m/44h/304h/1h/0/0", DerivationPath{44 + HardenedKeyStart, 304 + HardenedKeyStart, 1 + HardenedKeyStart, 0, 0}},
+	}
+	for _, e := range tests {
+		dp, err := ParsePath(e.path)
+		require.NoError(err)
+		require.Equal(e.dp, dp)
+	}
+	require.Equal("m/44'/304'/2'/0/5", IoTeXPath(2, 5).String())
+
+	for _, path := range []string{"", "44'/0", "m/", "m/a", "m/-1", "m/2147483648"} {
+		_, err := ParsePath(path)
+		require.ErrorIs(err, ErrInvalidPath)
+	}
+}
+
+func TestExtendedKey(t *testing.T) {
+	require := require.New(t)
+
+	// BIP-32 test vector 1
+	seed, _ := hex.DecodeString("000102030405060708090a0b0c0d0e0f")
+	m, err := NewMaster(seed)
+	require.NoError(err)
+	require.True(m.IsPrivate())
+	require.Equal("xpub661MyMwAqRbcFtXgS5sYJABqqG9YLmC4Q1Rdap9gSE8NqtwybGhePY2gZ29ESFjqJoCu1Rupje8YtGqsefD265TMg7usUDFdp6W1EGMcet8", m.Neuter().String())
+	k, err := m.Child(HardenedKeyStart)
+	require.NoError(err)
+	require.Equal("xpub68Gmy5EdvgibQVfPdqkBBCHxA5htiqg55crXYuXoQRKfDBFA1WEjWgP6LHhwBZeNK1VTsfTFUHCdrfp1bgwQ9xv5ski8PX9rL2dZXvgGDnw", k.Neuter().String())
+
+	// serialization round-trip
+	for _, key := range []*ExtendedKey{m, m.Neuter(), k, k.Neuter()} {
+		k2, err := ParseExtendedKey(key.String())
+		require.NoError(err)
+		require.Equal(key, k2)
+	}
+	s := k.String()
+	_, err = ParseExtendedKey(s[:len(s)-1] + "x")
+	require.ErrorIs(err, ErrInvalidExtendedKey)
+	_, err = ParseExtendedKey("xpub0")
+	require.ErrorIs(err, ErrInvalidExtendedKey)
+
+	// watch-only derivation matches private derivation
+	acct, err := m.Derive(IoTeXPath(0, 0)[:3])
+	require.NoError(err)
+	xpub := acct.Neuter()
+	_, err = xpub.Child(HardenedKeyStart)
+	require.Equal(ErrDeriveHardenedFromPublic, err)
+	_, err = xpub.PrivateKey()
+	require.Equal(ErrNotPrivate, err)
+	for i := uint32(0); i < 5; i++ {
+		prv, err := acct.Derive(DerivationPath{0, i})
+		require.NoError(err)
+		pub, err := xpub.Derive(DerivationPath{0, i})
+		require.NoError(err)
+		require.False(pub.IsPrivate())
+		require.EqualValues(5, pub.Depth())
+		require.Equal(i, pub.ChildNumber())
+		require.Equal(prv.Neuter(), pub)
+
+		sk, err := prv.PrivateKey()
+		require.NoError(err)
+		pk, err := pub.PublicKey()
+		require.NoError(err)
+		require.Equal(sk.PublicKey(), pk)
+		require.Equal(sk.PublicKey().Address(), pk.Address())
+	}
+}
+
+func TestEtherCompatibility(t *testing.T) {
+	require := require.New(t)
+
+	m, err := NewMasterFromMnemonic(testMnemonic, "")
+	require.NoError(err)
+	dp, err := ParsePath("m/44'/60'/0'/0/0")
+	require.NoError(err)
+	k, err := m.Derive(dp)
+	require.NoError(err)
+	sk, err := k.PrivateKey()
+	require.NoError(err)
+	require.Equal("9858effd232b4033e47d90003d41ec34ecaeda94", hex.EncodeToString(sk.PublicKey().Address().Bytes()))
+
+	_, err = NewMasterFromMnemonic("abandon", "")
+	require.ErrorIs(err, ErrInvalidMnemonic)
+	_, err = NewMaster(make([]byte, 8))
+	require.ErrorIs(err, ErrInvalidSeed)
+}
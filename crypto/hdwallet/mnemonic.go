@@ -0,0 +1,51 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package hdwallet
+
+import (
+	"github.com/pkg/errors"
+	"github.com/tyler-smith/go-bip39"
+)
+
+// const
+const (
+	// MnemonicEntropy128 is the entropy size of a 12-word mnemonic
+	MnemonicEntropy128 = 128
+	// MnemonicEntropy256 is the entropy size of a 24-word mnemonic
+	MnemonicEntropy256 = 256
+)
+
+var (
+	// ErrInvalidMnemonic indicates the mnemonic is invalid
+	ErrInvalidMnemonic = errors.New("invalid mnemonic")
+)
+
+// NewMnemonic generates a BIP-39 mnemonic with the given entropy size, which
+// must be a multiple of 32 in [128, 256]
+func NewMnemonic(bitSize int) (string, error) {
+	entropy, err := bip39.NewEntropy(bitSize)
+	if err != nil {
+		return "", errors.Wrap(err, "failed to generate entropy")
+	}
+	return bip39.NewMnemonic(entropy)
+}
+
+// ValidateMnemonic checks the words and checksum of a BIP-39 mnemonic
+func ValidateMnemonic(mnemonic string) error {
+	if _, err := bip39.EntropyFromMnemonic(mnemonic); err != nil {
+		return errors.Wrap(ErrInvalidMnemonic, err.Error())
+	}
+	return nil
+}
+
+// NewSeed derives the 64-byte BIP-39 seed from mnemonic and (optional) passphrase
+func NewSeed(mnemonic, passphrase string) ([]byte, error) {
+	if err := ValidateMnemonic(mnemonic); err != nil {
+		return nil, err
+	}
+	return bip39.NewSeed(mnemonic, passphrase), nil
+}
@@ -0,0 +1,84 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package hdwallet
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// const
+const (
+	// HardenedKeyStart is the index of the first hardened child key
+	HardenedKeyStart uint32 = 0x80000000
+
+	// IoTeXCoinType is the SLIP-44 coin type registered for IoTeX
+	IoTeXCoinType uint32 = 304
+)
+
+var (
+	// ErrInvalidPath indicates the derivation path is invalid
+	ErrInvalidPath = errors.New("invalid derivation path")
+)
+
+// DerivationPath is a BIP-32 derivation path, as a list of child indices from the master key
+type DerivationPath []uint32
+
+// ParsePath parses a derivation path like "m/44'/304'/0'/0/0", where the hardened
+// index can be marked by either ' or h
+func ParsePath(path string) (DerivationPath, error) {
+	elems := strings.Split(strings.TrimSpace(path), "/")
+	if len(elems) == 0 || elems[0] != "m" {
+		return nil, errors.Wrapf(ErrInvalidPath, "path %s must start with m", path)
+	}
+
+	var dp DerivationPath
+	for _, e := range elems[1:] {
+		var hardened bool
+		if strings.HasSuffix(e, "'") || strings.HasSuffix(e, "h") {
+			hardened = true
+			e = e[:len(e)-1]
+		}
+		i, err := strconv.ParseUint(e, 10, 32)
+		if err != nil || uint32(i) >= HardenedKeyStart {
+			return nil, errors.Wrapf(ErrInvalidPath, "invalid index %s in path %s", e, path)
+		}
+		if hardened {
+			i += uint64(HardenedKeyStart)
+		}
+		dp = append(dp, uint32(i))
+	}
+	return dp, nil
+}
+
+// IoTeXPath returns the BIP-44 path m/44'/304'/account'/0/index
+func IoTeXPath(account, index uint32) DerivationPath {
+	return DerivationPath{
+		44 + HardenedKeyStart,
+		IoTeXCoinType + HardenedKeyStart,
+		account + HardenedKeyStart,
+		0,
+		index,
+	}
+}
+
+// String returns the path in "m/44'/304'/0'/0/0" format
+func (dp DerivationPath) String() string {
+	var sb strings.Builder
+	sb.WriteString("m")
+	for _, i := range dp {
+		if i >= HardenedKeyStart {
+			fmt.Fprintf(&sb, "/%d'", i-HardenedKeyStart)
+		} else {
+			fmt.Fprintf(&sb, "/%d", i)
+		}
+	}
+	return sb.String()
+}
@@ -0,0 +1,260 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"bytes"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"io"
+	"math/big"
+	"os"
+
+	"github.com/dustinxie/gmsm/sm2"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// JWK key type and curves
+const (
+	JWKKeyTypeEC        = "EC"
+	JWKCurveSecp256k1   = "secp256k1"
+	JWKCurveP256sm2     = "SM2"
+	jwkCoordinateLength = 32
+)
+
+type (
+	// JWK is the JSON Web Key per RFC 7517, of secp256k1 or P256sm2 key
+	JWK struct {
+		Kty string `json:"kty"`
+		Crv string `json:"crv,omitempty"`
+		X   string `json:"x,omitempty"`
+		Y   string `json:"y,omitempty"`
+		D   string `json:"d,omitempty"`
+		Kid string `json:"kid,omitempty"`
+		Use string `json:"use,omitempty"`
+		Alg string `json:"alg,omitempty"`
+	}
+
+	// JWKS is the JSON Web Key Set per RFC 7517
+	JWKS struct {
+		Keys []*JWK `json:"keys"`
+	}
+
+	// KeySet is the set of public keys loaded from JWKS, indexed by kid
+	KeySet struct {
+		keys map[string]PublicKey
+	}
+)
+
+// PublicKeyToJWK converts the public key to JWK, the kid is set to the thumbprint
+// in base64url
+func PublicKeyToJWK(pk PublicKey) (*JWK, error) {
+	var (
+		crv  string
+		x, y *big.Int
+	)
+	switch k := pk.(type) {
+	case *secp256k1PubKey:
+		ecdsaPK, err := k.ecdsaPublicKey()
+		if err != nil {
+			return nil, err
+		}
+		crv, x, y = JWKCurveSecp256k1, ecdsaPK.X, ecdsaPK.Y
+	case *P256sm2PubKey:
+		sm2PK, err := k.sm2PublicKey()
+		if err != nil {
+			return nil, err
+		}
+		crv, x, y = JWKCurveP256sm2, sm2PK.X, sm2PK.Y
+	default:
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported public key type %T", pk)
+	}
+	jwk := &JWK{
+		Kty: JWKKeyTypeEC,
+		Crv: crv,
+		X:   jwkEncode(x),
+		Y:   jwkEncode(y),
+	}
+	tp, err := jwk.Thumbprint()
+	if err != nil {
+		return nil, err
+	}
+	jwk.Kid = base64.RawURLEncoding.EncodeToString(tp)
+	return jwk, nil
+}
+
+// PrivateKeyToJWK converts the private key to JWK, the kid is set to the thumbprint
+// in base64url
+func PrivateKeyToJWK(sk PrivateKey) (*JWK, error) {
+	var d *big.Int
+	switch k := sk.(type) {
+	case *secp256k1PrvKey:
+		d = k.D
+	case *P256sm2PrvKey:
+		d = k.PrivateKey.D
+	default:
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported private key type %T", sk)
+	}
+	jwk, err := PublicKeyToJWK(sk.PublicKey())
+	if err != nil {
+		return nil, err
+	}
+	jwk.D = jwkEncode(d)
+	return jwk, nil
+}
+
+// PublicKey returns the public key of JWK
+func (j *JWK) PublicKey() (PublicKey, error) {
+	if j.Kty != JWKKeyTypeEC {
+		return nil, errors.Wrapf(ErrPublicKey, "unsupported kty %s", j.Kty)
+	}
+	x, err := jwkDecode(j.X)
+	if err != nil {
+		return nil, errors.Wrap(ErrPublicKey, err.Error())
+	}
+	y, err := jwkDecode(j.Y)
+	if err != nil {
+		return nil, errors.Wrap(ErrPublicKey, err.Error())
+	}
+	point := append(append([]byte{4}, x...), y...)
+	switch j.Crv {
+	case JWKCurveSecp256k1:
+		if !crypto.S256().IsOnCurve(new(big.Int).SetBytes(x), new(big.Int).SetBytes(y)) {
+			return nil, errors.Wrap(ErrPublicKey, "point is not on curve")
+		}
+		return newSecp256k1PubKeyFromBytes(point)
+	case JWKCurveP256sm2:
+		px, py := elliptic.Unmarshal(sm2.P256Sm2(), point)
+		if px == nil {
+			return nil, errors.Wrap(ErrPublicKey, "point is not on curve")
+		}
+		return &P256sm2PubKey{
+			PublicKey: &sm2.PublicKey{Curve: sm2.P256Sm2(), X: px, Y: py},
+		}, nil
+	default:
+		return nil, errors.Wrapf(ErrPublicKey, "unsupported crv %s", j.Crv)
+	}
+}
+
+// PrivateKey returns the private key of JWK, the public key in JWK must match
+func (j *JWK) PrivateKey() (PrivateKey, error) {
+	pk, err := j.PublicKey()
+	if err != nil {
+		return nil, err
+	}
+	d, err := jwkDecode(j.D)
+	if err != nil {
+		return nil, errors.Wrap(ErrPrivateKey, err.Error())
+	}
+	var sk PrivateKey
+	switch j.Crv {
+	case JWKCurveSecp256k1:
+		sk, err = newSecp256k1PrvKeyFromBytes(d)
+	default:
+		sk, err = newP256sm2PrvKeyFromD(d)
+	}
+	if err != nil {
+		return nil, errors.Wrap(ErrPrivateKey, err.Error())
+	}
+	if !bytes.Equal(sk.PublicKey().Bytes(), pk.Bytes()) {
+		sk.Zero()
+		return nil, errors.Wrap(ErrPrivateKey, "private key does not match public key")
+	}
+	return sk, nil
+}
+
+// Thumbprint returns the SHA-256 JWK thumbprint per RFC 7638, which is computed
+// from the public key members only
+func (j *JWK) Thumbprint() ([]byte, error) {
+	if j.Kty != JWKKeyTypeEC || j.Crv == "" || j.X == "" || j.Y == "" {
+		return nil, errors.Wrap(ErrInvalidKey, "missing required members of EC key")
+	}
+	// required members in lexicographic order, without whitespace
+	b, err := json.Marshal(struct {
+		Crv string `json:"crv"`
+		Kty string `json:"kty"`
+		X   string `json:"x"`
+		Y   string `json:"y"`
+	}{j.Crv, j.Kty, j.X, j.Y})
+	if err != nil {
+		return nil, err
+	}
+	h := sha256.Sum256(b)
+	return h[:], nil
+}
+
+// ParseJWKS parses the JWKS from reader, such as a file or an HTTP response body
+//
+// keys of other kty or crv are skipped, so a mixed key set can be used. A key
+// without kid is indexed by its thumbprint in base64url
+func ParseJWKS(r io.Reader) (*KeySet, error) {
+	var jwks JWKS
+	if err := json.NewDecoder(r).Decode(&jwks); err != nil {
+		return nil, errors.Wrap(err, "failed to decode JWKS")
+	}
+	ks := &KeySet{
+		keys: make(map[string]PublicKey),
+	}
+	for i, jwk := range jwks.Keys {
+		if jwk == nil || jwk.Kty != JWKKeyTypeEC || (jwk.Crv != JWKCurveSecp256k1 && jwk.Crv != JWKCurveP256sm2) {
+			continue
+		}
+		pk, err := jwk.PublicKey()
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid key at index %d", i)
+		}
+		kid := jwk.Kid
+		if kid == "" {
+			tp, _ := jwk.Thumbprint()
+			kid = base64.RawURLEncoding.EncodeToString(tp)
+		}
+		if _, ok := ks.keys[kid]; ok {
+			return nil, errors.Errorf("duplicate kid %s", kid)
+		}
+		ks.keys[kid] = pk
+	}
+	return ks, nil
+}
+
+// ReadJWKSFromFile parses the JWKS from file
+func ReadJWKSFromFile(file string) (*KeySet, error) {
+	f, err := os.Open(file)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return ParseJWKS(f)
+}
+
+// Lookup returns the public key of kid
+func (ks *KeySet) Lookup(kid string) (PublicKey, bool) {
+	pk, ok := ks.keys[kid]
+	return pk, ok
+}
+
+// Len returns the number of keys in the set
+func (ks *KeySet) Len() int {
+	return len(ks.keys)
+}
+
+func jwkEncode(v *big.Int) string {
+	return base64.RawURLEncoding.EncodeToString(v.FillBytes(make([]byte, jwkCoordinateLength)))
+}
+
+func jwkDecode(s string) ([]byte, error) {
+	b, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	if len(b) != jwkCoordinateLength {
+		return nil, errors.Errorf("invalid length %d, need %d bytes", len(b), jwkCoordinateLength)
+	}
+	return b, nil
+}
@@ -0,0 +1,168 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/dustinxie/gmsm/sm2"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJWK(t *testing.T) {
+	require := require.New(t)
+
+	for _, v := range []struct {
+		gen func() (PrivateKey, error)
+		crv string
+	}{
+		{GenerateKey, "secp256k1"},
+		{GenerateKeySm2, "SM2"},
+	} {
+		sk, err := v.gen()
+		require.NoError(err)
+		pk := sk.PublicKey()
+
+		jwk, err := PublicKeyToJWK(pk)
+		require.NoError(err)
+		require.Equal("EC", jwk.Kty)
+		require.Equal(v.crv, jwk.Crv)
+		require.Empty(jwk.D)
+		pk1, err := jwk.PublicKey()
+		require.NoError(err)
+		require.Equal(pk.Bytes(), pk1.Bytes())
+		_, err = jwk.PrivateKey()
+		require.ErrorIs(err, ErrPrivateKey)
+
+		// thumbprint per RFC 7638
+		tp, err := jwk.Thumbprint()
+		require.NoError(err)
+		h := sha256.Sum256([]byte(`{"crv":"` + v.crv + `","kty":"EC","x":"` + jwk.X + `","y":"` + jwk.Y + `"}`))
+		require.Equal(h[:], tp)
+		require.Equal(base64.RawURLEncoding.EncodeToString(tp), jwk.Kid)
+
+		jwk, err = PrivateKeyToJWK(sk)
+		require.NoError(err)
+		require.NotEmpty(jwk.D)
+		tp1, err := jwk.Thumbprint()
+		require.NoError(err)
+		require.Equal(tp, tp1)
+		b, err := json.Marshal(jwk)
+		require.NoError(err)
+		var jwk1 JWK
+		require.NoError(json.Unmarshal(b, &jwk1))
+		sk1, err := jwk1.PrivateKey()
+		require.NoError(err)
+		require.Equal(sk.Bytes(), sk1.Bytes())
+
+		// mismatched public key
+		other, err := v.gen()
+		require.NoError(err)
+		jwk2, err := PublicKeyToJWK(other.PublicKey())
+		require.NoError(err)
+		jwk2.D = jwk.D
+		_, err = jwk2.PrivateKey()
+		require.ErrorIs(err, ErrPrivateKey)
+
+		// point not on curve
+		jwk2.Y = jwk.X
+		_, err = jwk2.PublicKey()
+		require.ErrorIs(err, ErrPublicKey)
+	}
+
+	// known key
+	sk, err := HexStringToPrivateKey(_opensslSK)
+	require.NoError(err)
+	jwk, err := PrivateKeyToJWK(sk)
+	require.NoError(err)
+	require.Equal("WRK0MP4oAYYINGiuQvQW0lWhzgSea1bo3-n9DUDto34", jwk.X)
+	require.Equal("WLprNKju-itutcC2k3_ysHyaZYG_Iba_N3YflhfVsmA", jwk.Y)
+	require.Equal("mGXhP24HupM4Q6XvI5MeD4EvObLCAuW8B61cRJjc3lY", jwk.D)
+
+	// invalid JWK
+	for _, v := range []*JWK{
+		{Kty: "RSA"},
+		{Kty: "EC", Crv: "P-256", X: jwk.X, Y: jwk.Y},
+		{Kty: "EC", Crv: "secp256k1", X: jwk.X},
+		{Kty: "EC", Crv: "secp256k1", X: jwk.X, Y: jwk.Y + "AA"},
+	} {
+		_, err = v.PublicKey()
+		require.ErrorIs(err, ErrPublicKey)
+	}
+	_, err = (&JWK{Kty: "EC"}).Thumbprint()
+	require.ErrorIs(err, ErrInvalidKey)
+	ed, err := GenerateKeyEd25519()
+	require.NoError(err)
+	_, err = PrivateKeyToJWK(ed)
+	require.ErrorIs(err, ErrInvalidKey)
+	_, err = PublicKeyToJWK(ed.PublicKey())
+	require.ErrorIs(err, ErrInvalidKey)
+
+	// point not on curve
+	_, err = PublicKeyToJWK(&secp256k1PubKey{raw: offCurveSecp256k1Key()})
+	require.ErrorIs(err, ErrPublicKey)
+	_, err = PublicKeyToJWK(&P256sm2PubKey{&sm2.PublicKey{Curve: sm2.P256Sm2()}})
+	require.ErrorIs(err, ErrPublicKey)
+}
+
+func TestJWKS(t *testing.T) {
+	require := require.New(t)
+
+	sk1, err := GenerateKey()
+	require.NoError(err)
+	sk2, err := GenerateKeySm2()
+	require.NoError(err)
+	jwk1, err := PublicKeyToJWK(sk1.PublicKey())
+	require.NoError(err)
+	jwk1.Kid = "key-1"
+	jwk2, err := PublicKeyToJWK(sk2.PublicKey())
+	require.NoError(err)
+	kid2 := jwk2.Kid
+	jwk2.Kid = ""
+	b, err := json.Marshal(JWKS{Keys: []*JWK{jwk1, jwk2}})
+	require.NoError(err)
+	// other key types are skipped
+	data := strings.Replace(string(b), `"keys":[`, `"keys":[{"kty":"RSA","kid":"rsa","n":"AQAB","e":"AQAB"},`, 1)
+
+	file := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(os.WriteFile(file, []byte(data), 0600))
+	ks, err := ReadJWKSFromFile(file)
+	require.NoError(err)
+	require.Equal(2, ks.Len())
+	pk, ok := ks.Lookup("key-1")
+	require.True(ok)
+	require.Equal(sk1.PublicKey().Bytes(), pk.Bytes())
+	// indexed by thumbprint without kid
+	pk, ok = ks.Lookup(kid2)
+	require.True(ok)
+	require.Equal(sk2.PublicKey().Bytes(), pk.Bytes())
+	_, ok = ks.Lookup("rsa")
+	require.False(ok)
+
+	// duplicate kid
+	jwk2.Kid = "key-1"
+	b, err = json.Marshal(JWKS{Keys: []*JWK{jwk1, jwk2}})
+	require.NoError(err)
+	_, err = ParseJWKS(strings.NewReader(string(b)))
+	require.Error(err)
+	// invalid key
+	jwk2.X = "AA"
+	b, err = json.Marshal(JWKS{Keys: []*JWK{jwk2}})
+	require.NoError(err)
+	_, err = ParseJWKS(strings.NewReader(string(b)))
+	require.ErrorIs(err, ErrPublicKey)
+	_, err = ParseJWKS(strings.NewReader("{"))
+	require.Error(err)
+	_, err = ReadJWKSFromFile(filepath.Join(t.TempDir(), "none.json"))
+	require.Error(err)
+}
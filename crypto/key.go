@@ -7,11 +7,12 @@
 package crypto
 
 import (
+	"crypto/ed25519"
 	"encoding/hex"
+	"fmt"
 	"io/ioutil"
 
 	"github.com/ethereum/go-ethereum/accounts"
-	"github.com/ethereum/go-ethereum/accounts/keystore"
 	"github.com/ethereum/go-ethereum/crypto/secp256k1"
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/pkg/errors"
@@ -26,11 +27,22 @@ const (
 
 	// 65-byte (r, s, v) format, where v is the recovery id
 	Secp256k1SigSizeWithRecID = 65
+
+	// 65-byte (r, s, v) format, where v is the recovery id plus P256sm2RecIDOffset
+	P256sm2SigSizeWithRecID = 65
+
+	// P256sm2RecIDOffset is added to the recovery id of a P256sm2 signature, to tell it apart from
+	// a secp256k1 signature of the same size, whose recovery id is 0/1 or 27/28
+	P256sm2RecIDOffset = 0x80
 )
 
 const (
-	secp256pubKeyLength = 65
-	secp256prvKeyLength = 32
+	secp256pubKeyLength           = 65
+	secp256pubKeyCompressedLength = 33
+	secp256prvKeyLength           = 32
+
+	// 2-byte codec of secp256k1 or P256sm2, followed by 33-byte compressed point
+	taggedPubKeyCompressedLength = 35
 )
 
 var (
@@ -42,18 +54,33 @@ var (
 	ErrPrivateKey = errors.New("invalid private key")
 )
 
+// KeyType is the algorithm of key
+type KeyType uint8
+
+// supported key types
+const (
+	KeyTypeSecp256k1 KeyType = iota + 1
+	KeyTypeP256sm2
+	KeyTypeEd25519
+	KeyTypeBLS12381
+)
+
 type (
 	// PublicKey represents a public key
 	PublicKey interface {
+		KeyType() KeyType
 		Bytes() []byte
+		CompressedBytes() []byte
 		HexString() string
 		EcdsaPublicKey() interface{}
 		Hash() []byte
 		Verify([]byte, []byte) bool
+		VerifySignature([]byte, *Signature) bool
 		Address() address.Address
 	}
 	// PrivateKey represents a private key
 	PrivateKey interface {
+		KeyType() KeyType
 		Bytes() []byte
 		HexString() string
 		EcdsaPrivateKey() interface{}
@@ -63,6 +90,22 @@ type (
 	}
 )
 
+// String returns the name of key type
+func (t KeyType) String() string {
+	switch t {
+	case KeyTypeSecp256k1:
+		return "secp256k1"
+	case KeyTypeP256sm2:
+		return "p256sm2"
+	case KeyTypeEd25519:
+		return "ed25519"
+	case KeyTypeBLS12381:
+		return "bls12-381"
+	default:
+		return fmt.Sprintf("KeyType(%d)", uint8(t))
+	}
+}
+
 // GenerateKey generates a SECP256k1 PrivateKey
 func GenerateKey() (PrivateKey, error) {
 	return newSecp256k1PrvKey()
@@ -73,6 +116,11 @@ func GenerateKeySm2() (PrivateKey, error) {
 	return newP256sm2PrvKey()
 }
 
+// GenerateKeyEd25519 generates an Ed25519 PrivateKey
+func GenerateKeyEd25519() (PrivateKey, error) {
+	return newEd25519PrvKey()
+}
+
 // HexStringToPublicKey decodes a string to PublicKey
 func HexStringToPublicKey(pubKey string) (PublicKey, error) {
 	b, err := hex.DecodeString(util.Remove0xPrefix(pubKey))
@@ -91,14 +139,34 @@ func HexStringToPrivateKey(prvKey string) (PrivateKey, error) {
 	return BytesToPrivateKey(b)
 }
 
-// BytesToPublicKey converts a byte slice to SECP256K1 PublicKey
+// BytesToPublicKey converts a byte slice to PublicKey, the key type is determined by length:
+// 33/64/65 bytes for SECP256K1, 32 bytes for Ed25519, 48 bytes for BLS12-381, and DER-encoded for P256sm2
+//
+// the key type is guessed, use DecodePublicKey for the tagged encoding which is unambiguous.
+// A 33-byte compressed key is always taken as secp256k1, while the compressed P256sm2 key
+// from CompressedBytes is 35 bytes in tagged encoding, which is decoded by DecodePublicKey
 func BytesToPublicKey(pubKey []byte) (PublicKey, error) {
+	// check against Ed25519
+	if len(pubKey) == ed25519.PublicKeySize {
+		return newEd25519PubKeyFromBytes(pubKey)
+	}
+
+	// check against BLS12-381
+	if len(pubKey) == BLSPubKeySize {
+		return newBLSPubKeyFromBytes(pubKey)
+	}
+
+	// check against tagged encoding of compressed key
+	if len(pubKey) == taggedPubKeyCompressedLength {
+		return DecodePublicKey(pubKey)
+	}
+
 	if len(pubKey) == secp256pubKeyLength-1 {
 		pubKey = append([]byte{4}, pubKey...)
 	}
 
-	// check against P256k1
-	if len(pubKey) == secp256pubKeyLength {
+	// check against P256k1, in uncompressed or compressed form
+	if len(pubKey) == secp256pubKeyLength || len(pubKey) == secp256pubKeyCompressedLength {
 		return newSecp256k1PubKeyFromBytes(pubKey)
 	}
 
@@ -109,13 +177,21 @@ func BytesToPublicKey(pubKey []byte) (PublicKey, error) {
 	return nil, ErrPublicKey
 }
 
-// BytesToPrivateKey converts a byte slice to SECP256K1 PrivateKey
+// BytesToPrivateKey converts a byte slice to PrivateKey, the key type is determined by length:
+// 32 bytes for SECP256K1, 64 bytes for Ed25519, and PKCS8-encoded for P256sm2
+//
+// the key type is guessed, use DecodePrivateKey for the tagged encoding which is unambiguous
 func BytesToPrivateKey(prvKey []byte) (PrivateKey, error) {
-	// check against P256sm2
+	// check against P256k1
 	if len(prvKey) == secp256prvKeyLength {
 		return newSecp256k1PrvKeyFromBytes(prvKey)
 	}
 
+	// check against Ed25519
+	if len(prvKey) == ed25519.PrivateKeySize {
+		return newEd25519PrvKeyFromBytes(prvKey)
+	}
+
 	// check against P256sm2
 	if k, err := newP256sm2PrvKeyFromBytes(prvKey); err == nil {
 		return k, nil
@@ -130,24 +206,27 @@ func KeystoreToPrivateKey(account accounts.Account, password string) (PrivateKey
 	if err != nil {
 		return nil, err
 	}
-	key, err := keystore.DecryptKey(keyJSON, password)
-	if err != nil {
-		return nil, err
-	}
-	return &secp256k1PrvKey{
-		PrivateKey: key.PrivateKey,
-	}, nil
+	return DecryptKeystore(keyJSON, password)
 }
 
-// RecoverPubkey recovers the public key from signature
+// RecoverPubkey recovers the public key from signature, use RecoverPubkeyFromSignature
+// for a parsed Signature
 func RecoverPubkey(msg, sig []byte) (PublicKey, error) {
+	if isP256sm2SigWithRecID(sig) {
+		return recoverP256sm2(msg, sig)
+	}
 	if pk, err := recoverSecp256k1(msg, sig); err == nil {
 		return pk, nil
 	}
-	// TODO: implement recover key for sm2
 	return nil, ErrInvalidKey
 }
 
+// RecoverPubkeyFromSignature recovers the public key from the parsed signature, which
+// must have the recovery id
+func RecoverPubkeyFromSignature(msg []byte, sig *Signature) (PublicKey, error) {
+	return sig.RecoverPubkey(msg)
+}
+
 func recoverSecp256k1(msg, sig []byte) (PublicKey, error) {
 	if len(sig) >= Secp256k1SigSizeWithRecID && sig[Secp256k1SigSize] >= 27 {
 		// when an Ethereum signature is calculated, 27 is added to recovery id
@@ -43,6 +43,13 @@ func TestKeypair(t *testing.T) {
 			nil,
 			nil,
 		},
+		{
+			// ed25519 keypair
+			"9d61b19deffd5a60ba844af492ec2cc44449c5697b326919703bac031cae7f60d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+			"d75a980182b10ab7d54bfed3c964073a0ee172f3daa62325af021a68f707511a",
+			nil,
+			nil,
+		},
 	}
 
 	for _, e := range tests {
@@ -74,6 +81,47 @@ func TestKeypair(t *testing.T) {
 			pk2, err = HexStringToPublicKey(e.pk[2:])
 			require.NoError(err)
 			require.Equal(pk, pk2)
+
+			// test compressed key
+			b := pk.CompressedBytes()
+			require.Equal(secp256pubKeyCompressedLength, len(b))
+			pk2, err = HexStringToPublicKey(hex.EncodeToString(b))
+			require.NoError(err)
+			require.Equal(pk, pk2)
+			require.Equal(pk.Hash(), pk2.Hash())
+			require.Equal(pk.Address(), pk2.Address())
+		}
+	}
+}
+
+func TestPublicKeyRoundTrip(t *testing.T) {
+	require := require.New(t)
+
+	for _, gen := range []func() (PrivateKey, error){GenerateKey, GenerateKeySm2, GenerateKeyEd25519, GenerateKeyBLS} {
+		for i := 0; i < 8; i++ {
+			sk, err := gen()
+			require.NoError(err)
+			pk := sk.PublicKey()
+
+			pk2, err := BytesToPublicKey(pk.Bytes())
+			require.NoError(err)
+			require.Equal(pk.Bytes(), pk2.Bytes())
+			b, err := EncodePublicKey(pk)
+			require.NoError(err)
+			pk3, err := DecodePublicKey(b)
+			require.NoError(err)
+			for _, k := range []PublicKey{pk2, pk3} {
+				require.Equal(pk.KeyType(), k.KeyType())
+				require.Equal(pk.CompressedBytes(), k.CompressedBytes())
+				require.Equal(pk.Hash(), k.Hash())
+				require.Equal(pk.Address(), k.Address())
+			}
+
+			pk4, err := BytesToPublicKey(pk.CompressedBytes())
+			require.NoError(err)
+			require.Equal(pk.KeyType(), pk4.KeyType())
+			require.Equal(pk.Bytes(), pk4.Bytes())
+			require.Equal(pk.Hash(), pk4.Hash())
 		}
 	}
 }
@@ -0,0 +1,230 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"bytes"
+	"crypto/ed25519"
+	"crypto/elliptic"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+
+	"github.com/dustinxie/gmsm/sm2"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/go-pkgs/util"
+)
+
+// the tagged encoding of key is the unsigned varint of the multicodec code of key
+// type, followed by the key in below format:
+//   - public key: 33-byte compressed point for secp256k1 and P256sm2, 32 bytes for
+//     Ed25519, 48-byte compressed G1 point for BLS12-381
+//   - private key: 32-byte secret for secp256k1, P256sm2 and BLS12-381, 32-byte seed
+//     for Ed25519
+//
+// SM2 private key is not registered in multicodec, a code in the private use area
+// is taken for it
+const (
+	codecSecp256k1Pub uint64 = 0xe7
+	codecP256sm2Pub   uint64 = 0x1206
+	codecEd25519Pub   uint64 = 0xed
+	codecBLS12381Pub  uint64 = 0xea
+
+	codecSecp256k1Prv uint64 = 0x1301
+	codecP256sm2Prv   uint64 = 0x301206
+	codecEd25519Prv   uint64 = 0x1300
+	codecBLS12381Prv  uint64 = 0x1309
+)
+
+var (
+	pubKeyCodec = map[KeyType]uint64{
+		KeyTypeSecp256k1: codecSecp256k1Pub,
+		KeyTypeP256sm2:   codecP256sm2Pub,
+		KeyTypeEd25519:   codecEd25519Pub,
+		KeyTypeBLS12381:  codecBLS12381Pub,
+	}
+	prvKeyCodec = map[KeyType]uint64{
+		KeyTypeSecp256k1: codecSecp256k1Prv,
+		KeyTypeP256sm2:   codecP256sm2Prv,
+		KeyTypeEd25519:   codecEd25519Prv,
+		KeyTypeBLS12381:  codecBLS12381Prv,
+	}
+)
+
+// EncodePublicKey encodes the public key with its key type as prefix
+func EncodePublicKey(pk PublicKey) ([]byte, error) {
+	code, ok := pubKeyCodec[pk.KeyType()]
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported key type %s", pk.KeyType())
+	}
+	data := pk.CompressedBytes()
+	if k, ok := pk.(*P256sm2PubKey); ok {
+		// the compressed form of P256sm2 is already tagged
+		data = k.compressedPoint()
+	}
+	if data == nil {
+		return nil, ErrPublicKey
+	}
+	return tagKey(code, data), nil
+}
+
+// DecodePublicKey decodes the public key encoded by EncodePublicKey
+func DecodePublicKey(b []byte) (PublicKey, error) {
+	code, data, err := untagKey(b)
+	if err != nil {
+		return nil, errors.Wrap(ErrPublicKey, err.Error())
+	}
+	switch code {
+	case codecSecp256k1Pub:
+		if !isCompressedP256k1PubkeyBytes(data) {
+			return nil, ErrPublicKey
+		}
+		return newSecp256k1PubKeyFromBytes(data)
+	case codecP256sm2Pub:
+		x, y := elliptic.UnmarshalCompressed(sm2.P256Sm2(), data)
+		if x == nil {
+			return nil, ErrPublicKey
+		}
+		return &P256sm2PubKey{
+			PublicKey: &sm2.PublicKey{Curve: sm2.P256Sm2(), X: x, Y: y},
+		}, nil
+	case codecEd25519Pub:
+		return newEd25519PubKeyFromBytes(data)
+	case codecBLS12381Pub:
+		return newBLSPubKeyFromBytes(data)
+	default:
+		return nil, errors.Wrapf(ErrPublicKey, "unknown key codec 0x%x", code)
+	}
+}
+
+// EncodePrivateKey encodes the private key with its key type as prefix
+func EncodePrivateKey(sk PrivateKey) ([]byte, error) {
+	code, ok := prvKeyCodec[sk.KeyType()]
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported key type %s", sk.KeyType())
+	}
+	var data []byte
+	switch k := sk.(type) {
+	case *P256sm2PrvKey:
+		data = k.D()
+	case *ed25519PrvKey:
+		data = k.Seed()
+	default:
+		data = sk.Bytes()
+	}
+	return tagKey(code, data), nil
+}
+
+// DecodePrivateKey decodes the private key encoded by EncodePrivateKey
+func DecodePrivateKey(b []byte) (PrivateKey, error) {
+	code, data, err := untagKey(b)
+	if err != nil {
+		return nil, errors.Wrap(ErrPrivateKey, err.Error())
+	}
+	switch code {
+	case codecSecp256k1Prv:
+		if len(data) != secp256prvKeyLength {
+			return nil, ErrPrivateKey
+		}
+		return newSecp256k1PrvKeyFromBytes(data)
+	case codecP256sm2Prv:
+		sk, err := newP256sm2PrvKeyFromD(data)
+		if err != nil {
+			return nil, errors.Wrap(ErrPrivateKey, err.Error())
+		}
+		return sk, nil
+	case codecEd25519Prv:
+		if len(data) != ed25519.SeedSize {
+			return nil, ErrPrivateKey
+		}
+		return &ed25519PrvKey{
+			PrivateKey: ed25519.NewKeyFromSeed(data),
+		}, nil
+	case codecBLS12381Prv:
+		return BytesToBLSPrivateKey(data)
+	default:
+		return nil, errors.Wrapf(ErrPrivateKey, "unknown key codec 0x%x", code)
+	}
+}
+
+func tagKey(code uint64, data []byte) []byte {
+	b := binary.AppendUvarint(make([]byte, 0, binary.MaxVarintLen64+len(data)), code)
+	return append(b, data...)
+}
+
+func untagKey(b []byte) (uint64, []byte, error) {
+	code, n := binary.Uvarint(b)
+	if n <= 0 {
+		return 0, nil, errors.New("invalid key codec")
+	}
+	// reject non-minimal varint, so a key has only one valid encoding
+	if !bytes.Equal(binary.AppendUvarint(nil, code), b[:n]) {
+		return 0, nil, errors.New("non-minimal key codec")
+	}
+	return code, b[n:], nil
+}
+
+//======================================
+// text and JSON marshaling
+//======================================
+
+// the text form of key is the hex string of tagged encoding, the JSON form is the
+// text form in JSON string
+
+func marshalPublicKeyText(pk PublicKey) ([]byte, error) {
+	b, err := EncodePublicKey(pk)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(hex.EncodeToString(b)), nil
+}
+
+func marshalPrivateKeyText(sk PrivateKey) ([]byte, error) {
+	b, err := EncodePrivateKey(sk)
+	if err != nil {
+		return nil, err
+	}
+	return []byte(hex.EncodeToString(b)), nil
+}
+
+func unmarshalPublicKeyText(text []byte, t KeyType) (PublicKey, error) {
+	b, err := hex.DecodeString(util.Remove0xPrefix(string(text)))
+	if err != nil {
+		return nil, errors.Wrap(ErrPublicKey, err.Error())
+	}
+	pk, err := DecodePublicKey(b)
+	if err != nil {
+		return nil, err
+	}
+	if pk.KeyType() != t {
+		return nil, errors.Wrapf(ErrPublicKey, "expect %s key, got %s", t, pk.KeyType())
+	}
+	return pk, nil
+}
+
+func unmarshalPrivateKeyText(text []byte, t KeyType) (PrivateKey, error) {
+	b, err := hex.DecodeString(util.Remove0xPrefix(string(text)))
+	if err != nil {
+		return nil, errors.Wrap(ErrPrivateKey, err.Error())
+	}
+	sk, err := DecodePrivateKey(b)
+	if err != nil {
+		return nil, err
+	}
+	if sk.KeyType() != t {
+		return nil, errors.Wrapf(ErrPrivateKey, "expect %s key, got %s", t, sk.KeyType())
+	}
+	return sk, nil
+}
+
+func marshalKeyJSON(text []byte, err error) ([]byte, error) {
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(string(text))
+}
@@ -0,0 +1,102 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyCodec(t *testing.T) {
+	require := require.New(t)
+
+	bls, err := GenerateKeyBLS()
+	require.NoError(err)
+	for _, v := range []struct {
+		gen    func() (PrivateKey, error)
+		kt     KeyType
+		name   string
+		prefix string
+	}{
+		{GenerateKey, KeyTypeSecp256k1, "secp256k1", "e7"},
+		{GenerateKeySm2, KeyTypeP256sm2, "p256sm2", "8624"},
+		{GenerateKeyEd25519, KeyTypeEd25519, "ed25519", "ed01"},
+		{func() (PrivateKey, error) { return bls, nil }, KeyTypeBLS12381, "bls12-381", "ea01"},
+	} {
+		sk, err := v.gen()
+		require.NoError(err)
+		pk := sk.PublicKey()
+		require.Equal(v.kt, sk.KeyType())
+		require.Equal(v.kt, pk.KeyType())
+		require.Equal(v.name, v.kt.String())
+
+		b, err := EncodePublicKey(pk)
+		require.NoError(err)
+		require.Equal(v.prefix, hex.EncodeToString(b[:len(v.prefix)/2]))
+		pk1, err := DecodePublicKey(b)
+		require.NoError(err)
+		require.Equal(pk.Bytes(), pk1.Bytes())
+		require.Equal(v.kt, pk1.KeyType())
+
+		// codec in non-minimal varint
+		_, n := binary.Uvarint(b)
+		nonMinimal := append(append([]byte{}, b[:n-1]...), b[n-1]|0x80, 0)
+		_, err = DecodePublicKey(append(nonMinimal, b[n:]...))
+		require.ErrorIs(err, ErrPublicKey)
+
+		b, err = EncodePrivateKey(sk)
+		require.NoError(err)
+		sk1, err := DecodePrivateKey(b)
+		require.NoError(err)
+		require.Equal(sk.Bytes(), sk1.Bytes())
+		require.Equal(v.kt, sk1.KeyType())
+
+		// truncated key
+		_, err = DecodePublicKey(b[:len(b)-1])
+		require.Error(err)
+		_, err = DecodePrivateKey(b[:len(b)-1])
+		require.Error(err)
+
+		// text and JSON
+		text, err := pk.(interface{ MarshalText() ([]byte, error) }).MarshalText()
+		require.NoError(err)
+		js, err := json.Marshal(pk)
+		require.NoError(err)
+		require.Equal(`"`+string(text)+`"`, string(js))
+		require.NoError(json.Unmarshal(js, pk1))
+		require.Equal(pk.Bytes(), pk1.Bytes())
+		js, err = json.Marshal(sk)
+		require.NoError(err)
+		require.NoError(json.Unmarshal(js, sk1))
+		require.Equal(sk.Bytes(), sk1.Bytes())
+		// public and private key are not interchangeable
+		require.ErrorIs(json.Unmarshal(js, pk1), ErrPublicKey)
+	}
+
+	// key type must match in unmarshaling
+	sk, err := GenerateKey()
+	require.NoError(err)
+	sm2, err := GenerateKeySm2()
+	require.NoError(err)
+	js, err := json.Marshal(sm2)
+	require.NoError(err)
+	require.ErrorIs(json.Unmarshal(js, sk), ErrPrivateKey)
+
+	// unknown codec
+	_, err = DecodePublicKey([]byte{0x01, 0x02})
+	require.ErrorIs(err, ErrPublicKey)
+	_, err = DecodePrivateKey([]byte{0xff})
+	require.ErrorIs(err, ErrPrivateKey)
+	_, err = DecodePublicKey(nil)
+	require.ErrorIs(err, ErrPublicKey)
+	_, err = DecodePrivateKey(nil)
+	require.ErrorIs(err, ErrPrivateKey)
+}
@@ -0,0 +1,339 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/ed25519"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/google/uuid"
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+)
+
+// const
+const (
+	// StandardScryptN is the N parameter of scrypt, using 256MB memory and taking ~1s CPU time
+	StandardScryptN = keystore.StandardScryptN
+	// StandardScryptP is the P parameter of scrypt, using 256MB memory and taking ~1s CPU time
+	StandardScryptP = keystore.StandardScryptP
+	// LightScryptN is the N parameter of scrypt, using 4MB memory and taking ~100ms CPU time
+	LightScryptN = keystore.LightScryptN
+	// LightScryptP is the P parameter of scrypt, using 4MB memory and taking ~100ms CPU time
+	LightScryptP = keystore.LightScryptP
+)
+
+const (
+	keystoreVersion     = 3
+	keystoreTypeP256sm2 = "p256sm2"
+	keystoreTypeEd25519 = "ed25519"
+)
+
+var (
+	// ErrKeyNotFound indicates the key does not exist in keystore
+	ErrKeyNotFound = errors.New("key not found in keystore")
+	// ErrKeyExists indicates the key already exists in keystore
+	ErrKeyExists = errors.New("key already exists in keystore")
+)
+
+type (
+	// KeyStore manages the encrypted private keys in a directory, one key per file
+	// in Web3 secret storage format
+	KeyStore struct {
+		mu      sync.Mutex
+		dir     string
+		scryptN int
+		scryptP int
+	}
+
+	// KeyStoreOption is an option of KeyStore
+	KeyStoreOption func(*KeyStore)
+
+	// keystoreJSON is the Web3 secret storage (version 3) file, with an extra
+	// key type field to tell P256sm2 and Ed25519 keys apart from secp256k1
+	keystoreJSON struct {
+		Address string              `json:"address"`
+		Crypto  keystore.CryptoJSON `json:"crypto"`
+		ID      string              `json:"id"`
+		Version int                 `json:"version"`
+		KeyType string              `json:"keytype,omitempty"`
+	}
+)
+
+// ScryptOption sets the scrypt parameters to encrypt keys
+func ScryptOption(n, p int) KeyStoreOption {
+	return func(ks *KeyStore) {
+		ks.scryptN = n
+		ks.scryptP = p
+	}
+}
+
+// NewKeyStore creates a keystore in the directory, which is created if not exist
+func NewKeyStore(dir string, opts ...KeyStoreOption) (*KeyStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, errors.Wrapf(err, "failed to create keystore directory %s", dir)
+	}
+	ks := &KeyStore{
+		dir:     dir,
+		scryptN: StandardScryptN,
+		scryptP: StandardScryptP,
+	}
+	for _, opt := range opts {
+		opt(ks)
+	}
+	return ks, nil
+}
+
+// Import encrypts the private key with password and stores it into keystore
+func (ks *KeyStore) Import(key PrivateKey, password string) (address.Address, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	addr := key.PublicKey().Address()
+	if _, err := ks.find(addr); err == nil {
+		return nil, errors.Wrapf(ErrKeyExists, "address %s", addr.String())
+	}
+	keyJSON, err := EncryptKeystore(key, password, ks.scryptN, ks.scryptP)
+	if err != nil {
+		return nil, err
+	}
+	if err := writeFileAtomic(filepath.Join(ks.dir, keystoreFileName(addr)), keyJSON); err != nil {
+		return nil, err
+	}
+	return addr, nil
+}
+
+// Export decrypts and returns the private key of the address
+func (ks *KeyStore) Export(addr address.Address, password string) (PrivateKey, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	_, key, err := ks.decrypt(addr, password)
+	return key, err
+}
+
+// List returns the addresses of all keys in keystore, sorted in ascending order
+func (ks *KeyStore) List() ([]address.Address, error) {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	files, err := ks.files()
+	if err != nil {
+		return nil, err
+	}
+	addrs := make([]address.Address, 0, len(files))
+	for _, f := range files {
+		addrs = append(addrs, f.addr)
+	}
+	sort.Slice(addrs, func(i, j int) bool {
+		return addrs[i].String() < addrs[j].String()
+	})
+	return addrs, nil
+}
+
+// ChangePassword re-encrypts the key of the address with new password
+func (ks *KeyStore) ChangePassword(addr address.Address, oldPwd, newPwd string) error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	file, key, err := ks.decrypt(addr, oldPwd)
+	if err != nil {
+		return err
+	}
+	defer key.Zero()
+	keyJSON, err := EncryptKeystore(key, newPwd, ks.scryptN, ks.scryptP)
+	if err != nil {
+		return err
+	}
+	return writeFileAtomic(file, keyJSON)
+}
+
+// Delete removes the key of the address, the password is required to confirm the deletion
+func (ks *KeyStore) Delete(addr address.Address, password string) error {
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	file, key, err := ks.decrypt(addr, password)
+	if err != nil {
+		return err
+	}
+	key.Zero()
+	return os.Remove(file)
+}
+
+type keystoreFile struct {
+	path string
+	addr address.Address
+}
+
+// files returns all valid keystore files in the directory
+func (ks *KeyStore) files() ([]keystoreFile, error) {
+	entries, err := os.ReadDir(ks.dir)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to read keystore directory %s", ks.dir)
+	}
+	var files []keystoreFile
+	for _, e := range entries {
+		// skip sub-directories, editor backups and hidden files
+		if e.IsDir() || strings.HasSuffix(e.Name(), "~") || strings.HasPrefix(e.Name(), ".") {
+			continue
+		}
+		path := filepath.Join(ks.dir, e.Name())
+		data, err := os.ReadFile(path)
+		if err != nil {
+			continue
+		}
+		var k keystoreJSON
+		if err := json.Unmarshal(data, &k); err != nil {
+			continue
+		}
+		b, err := hex.DecodeString(k.Address)
+		if err != nil {
+			continue
+		}
+		addr, err := address.FromBytes(b)
+		if err != nil {
+			continue
+		}
+		files = append(files, keystoreFile{path, addr})
+	}
+	return files, nil
+}
+
+func (ks *KeyStore) find(addr address.Address) (string, error) {
+	files, err := ks.files()
+	if err != nil {
+		return "", err
+	}
+	for _, f := range files {
+		if f.addr.String() == addr.String() {
+			return f.path, nil
+		}
+	}
+	return "", errors.Wrapf(ErrKeyNotFound, "address %s", addr.String())
+}
+
+func (ks *KeyStore) decrypt(addr address.Address, password string) (string, PrivateKey, error) {
+	file, err := ks.find(addr)
+	if err != nil {
+		return "", nil, err
+	}
+	keyJSON, err := os.ReadFile(file)
+	if err != nil {
+		return "", nil, err
+	}
+	key, err := DecryptKeystore(keyJSON, password)
+	if err != nil {
+		return "", nil, err
+	}
+	return file, key, nil
+}
+
+// EncryptKeystore encrypts the private key with password into Web3 secret storage JSON
+func EncryptKeystore(key PrivateKey, password string, scryptN, scryptP int) ([]byte, error) {
+	var (
+		data    []byte
+		keyType string
+	)
+	switch k := key.(type) {
+	case *secp256k1PrvKey:
+		data = k.Bytes()
+	case *P256sm2PrvKey:
+		data = k.D()
+		keyType = keystoreTypeP256sm2
+	case *ed25519PrvKey:
+		data = k.Seed()
+		keyType = keystoreTypeEd25519
+	default:
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported private key type %T", key)
+	}
+	cryptoJSON, err := keystore.EncryptDataV3(data, []byte(password), scryptN, scryptP)
+	if err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewRandom()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to generate key id")
+	}
+	return json.Marshal(&keystoreJSON{
+		Address: hex.EncodeToString(key.PublicKey().Hash()),
+		Crypto:  cryptoJSON,
+		ID:      id.String(),
+		Version: keystoreVersion,
+		KeyType: keyType,
+	})
+}
+
+// DecryptKeystore decrypts the Web3 secret storage JSON into private key
+func DecryptKeystore(keyJSON []byte, password string) (PrivateKey, error) {
+	var k keystoreJSON
+	if err := json.Unmarshal(keyJSON, &k); err != nil {
+		return nil, errors.Wrap(err, "failed to unmarshal keystore")
+	}
+	switch k.KeyType {
+	case "":
+		key, err := keystore.DecryptKey(keyJSON, password)
+		if err != nil {
+			return nil, err
+		}
+		return &secp256k1PrvKey{
+			PrivateKey: key.PrivateKey,
+		}, nil
+	case keystoreTypeP256sm2:
+		d, err := keystore.DecryptDataV3(k.Crypto, password)
+		if err != nil {
+			return nil, err
+		}
+		return newP256sm2PrvKeyFromD(d)
+	case keystoreTypeEd25519:
+		seed, err := keystore.DecryptDataV3(k.Crypto, password)
+		if err != nil {
+			return nil, err
+		}
+		if len(seed) != ed25519.SeedSize {
+			return nil, ErrPrivateKey
+		}
+		return &ed25519PrvKey{
+			PrivateKey: ed25519.NewKeyFromSeed(seed),
+		}, nil
+	default:
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported key type %s", k.KeyType)
+	}
+}
+
+// keystoreFileName follows the Ethereum keystore convention: UTC--<created_at UTC ISO8601>--<address hex>
+func keystoreFileName(addr address.Address) string {
+	ts := time.Now().UTC()
+	return fmt.Sprintf("UTC--%s--%s", ts.Format("2006-01-02T15-04-05.000000000Z"), hex.EncodeToString(addr.Bytes()))
+}
+
+// writeFileAtomic writes to a temp file with 0600 permission, then renames it to the target
+func writeFileAtomic(file string, data []byte) error {
+	f, err := os.CreateTemp(filepath.Dir(file), "."+filepath.Base(file)+".tmp")
+	if err != nil {
+		return err
+	}
+	if _, err := f.Write(data); err != nil {
+		f.Close()
+		os.Remove(f.Name())
+		return err
+	}
+	if err := f.Close(); err != nil {
+		os.Remove(f.Name())
+		return err
+	}
+	return os.Rename(f.Name(), file)
+}
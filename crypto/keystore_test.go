@@ -0,0 +1,97 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/keystore"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeyStore(t *testing.T) {
+	require := require.New(t)
+
+	ks, err := NewKeyStore(t.TempDir(), ScryptOption(LightScryptN, LightScryptP))
+	require.NoError(err)
+	addrs, err := ks.List()
+	require.NoError(err)
+	require.Empty(addrs)
+
+	sk1, err := GenerateKey()
+	require.NoError(err)
+	sk2, err := GenerateKeySm2()
+	require.NoError(err)
+	sk3, err := GenerateKeyEd25519()
+	require.NoError(err)
+	pwd, pwd2 := "s8fjl*[]>?<", "()Jh'00-.,`~nu5"
+
+	for _, sk := range []PrivateKey{sk1, sk2, sk3} {
+		addr, err := ks.Import(sk, pwd)
+		require.NoError(err)
+		require.Equal(sk.PublicKey().Address(), addr)
+		_, err = ks.Import(sk, pwd)
+		require.ErrorIs(err, ErrKeyExists)
+
+		sk3, err := ks.Export(addr, pwd)
+		require.NoError(err)
+		require.Equal(sk, sk3)
+		_, err = ks.Export(addr, pwd2)
+		require.Equal(keystore.ErrDecrypt, err)
+
+		require.Equal(keystore.ErrDecrypt, ks.ChangePassword(addr, pwd2, pwd))
+		require.NoError(ks.ChangePassword(addr, pwd, pwd2))
+		_, err = ks.Export(addr, pwd)
+		require.Equal(keystore.ErrDecrypt, err)
+		sk3, err = ks.Export(addr, pwd2)
+		require.NoError(err)
+		require.Equal(sk, sk3)
+	}
+
+	addrs, err = ks.List()
+	require.NoError(err)
+	require.Equal(3, len(addrs))
+	require.Contains(addrs, sk1.PublicKey().Address())
+	require.Contains(addrs, sk2.PublicKey().Address())
+	require.Contains(addrs, sk3.PublicKey().Address())
+
+	require.Equal(keystore.ErrDecrypt, ks.Delete(addrs[0], pwd))
+	require.NoError(ks.Delete(addrs[0], pwd2))
+	_, err = ks.Export(addrs[0], pwd2)
+	require.ErrorIs(err, ErrKeyNotFound)
+	left, err := ks.List()
+	require.NoError(err)
+	require.Equal(addrs[1:], left)
+}
+
+func TestKeystoreCompatibility(t *testing.T) {
+	require := require.New(t)
+
+	// keystore written by go-ethereum
+	dir := t.TempDir()
+	account, err := keystore.StoreKey(dir, "pwd", LightScryptN, LightScryptP)
+	require.NoError(err)
+	sk, err := KeystoreToPrivateKey(account, "pwd")
+	require.NoError(err)
+	require.Equal(account.Address.Bytes(), sk.PublicKey().Hash())
+
+	ks, err := NewKeyStore(dir, ScryptOption(LightScryptN, LightScryptP))
+	require.NoError(err)
+	addrs, err := ks.List()
+	require.NoError(err)
+	require.Equal(1, len(addrs))
+	sk2, err := ks.Export(addrs[0], "pwd")
+	require.NoError(err)
+	require.Equal(sk, sk2)
+
+	// secp256k1 keystore can be read by go-ethereum
+	keyJSON, err := EncryptKeystore(sk, "pwd", LightScryptN, LightScryptP)
+	require.NoError(err)
+	key, err := keystore.DecryptKey(keyJSON, "pwd")
+	require.NoError(err)
+	require.Equal(sk.EcdsaPrivateKey(), key.PrivateKey)
+}
@@ -7,15 +7,29 @@
 package crypto
 
 import (
+	"encoding/hex"
+	"encoding/json"
+	"math/bits"
+
+	"github.com/pkg/errors"
+
 	"github.com/iotexproject/go-pkgs/hash"
+	"github.com/iotexproject/go-pkgs/util"
 )
 
-// Merkle tree struct
-type Merkle struct {
-	root hash.Hash256
-	leaf []hash.Hash256
-	size int
-}
+type (
+	// Merkle tree struct
+	Merkle struct {
+		root  hash.Hash256
+		leaf  []hash.Hash256
+		size  int
+		count int
+	}
+
+	// MerkleProof is the inclusion proof of a leaf, which is the sibling hashes on
+	// the path from leaf to root
+	MerkleProof []hash.Hash256
+)
 
 // NewMerkleTree creates a merkle tree given hashed leaves
 func NewMerkleTree(leaves []hash.Hash256) *Merkle {
@@ -25,8 +39,9 @@ func NewMerkleTree(leaves []hash.Hash256) *Merkle {
 	}
 
 	mk := &Merkle{
-		leaf: make([]hash.Hash256, (size+1)>>1<<1),
-		size: size,
+		leaf:  make([]hash.Hash256, (size+1)>>1<<1),
+		size:  size,
+		count: size,
 	}
 
 	copy(mk.leaf, leaves)
@@ -79,3 +94,159 @@ func (mk *Merkle) HashTree() hash.Hash256 {
 	mk.root = merkle[0]
 	return mk.root
 }
+
+// Proof returns the inclusion proof of the leaf at index
+//
+// the last node of a level with odd number of nodes is paired with itself, same as
+// in NewMerkleTree, so its sibling in the proof is the node itself
+func (mk *Merkle) Proof(index int) (MerkleProof, error) {
+	if index < 0 || index >= mk.count {
+		return nil, errors.Errorf("index %d out of range [0, %d)", index, mk.count)
+	}
+	var (
+		proof  = MerkleProof{}
+		levels = mk.levels()
+	)
+	for _, level := range levels[:len(levels)-1] {
+		sibling := index ^ 1
+		if sibling == len(level) {
+			sibling = index
+		}
+		proof = append(proof, level[sibling])
+		index >>= 1
+	}
+	return proof, nil
+}
+
+// VerifyProof verifies the proof that leaf is at index of the merkle tree of root
+//
+// leaf and interior node are hashed the same way, and the number of leaves is not
+// committed to by the root, so the proof only shows that leaf is a node of the tree:
+//   - an interior node at height k, with the proof below it removed, is also valid
+//     as a "leaf" at index>>k
+//   - a proof of the padded copy of last leaf in a tree of odd size is also valid
+//
+// use VerifyProofWithSize if the number of leaves is known, or check len(proof)
+// against it
+func VerifyProof(root, leaf hash.Hash256, index int, proof MerkleProof) bool {
+	if index < 0 {
+		return false
+	}
+	h := leaf
+	for _, sibling := range proof {
+		if index&1 == 0 {
+			h = merkleParent(h, sibling)
+		} else {
+			h = merkleParent(sibling, h)
+		}
+		index >>= 1
+	}
+	return index == 0 && h == root
+}
+
+// VerifyProofWithSize verifies the proof that leaf is at index of the merkle tree
+// of root with size leaves, the proof must have the length of tree depth
+func VerifyProofWithSize(root, leaf hash.Hash256, index, size int, proof MerkleProof) bool {
+	if size <= 0 || index >= size || len(proof) != bits.Len(uint(size-1)) {
+		return false
+	}
+	return VerifyProof(root, leaf, index, proof)
+}
+
+// levels returns the nodes of each level from leaves to root, the padded copy of
+// last node is not included
+func (mk *Merkle) levels() [][]hash.Hash256 {
+	level := mk.leaf[:mk.count]
+	levels := [][]hash.Hash256{level}
+	for len(level) > 1 {
+		next := make([]hash.Hash256, (len(level)+1)>>1)
+		for i := range next {
+			left, right := level[i<<1], level[i<<1]
+			if i<<1+1 < len(level) {
+				right = level[i<<1+1]
+			}
+			next[i] = merkleParent(left, right)
+		}
+		levels = append(levels, next)
+		level = next
+	}
+	return levels
+}
+
+func merkleParent(left, right hash.Hash256) hash.Hash256 {
+	h := make([]byte, 0, 64)
+	h = append(h, left[:]...)
+	h = append(h, right[:]...)
+	return hash.Hash256b(h)
+}
+
+//======================================
+// proof encoding
+//======================================
+
+// Bytes returns the proof in binary, which is the concatenation of sibling hashes
+func (p MerkleProof) Bytes() []byte {
+	b := make([]byte, 0, len(p)*32)
+	for i := range p {
+		b = append(b, p[i][:]...)
+	}
+	return b
+}
+
+// BytesToMerkleProof decodes the proof encoded by MerkleProof.Bytes
+func BytesToMerkleProof(b []byte) (MerkleProof, error) {
+	if len(b)%32 != 0 {
+		return nil, errors.Errorf("invalid proof length %d, need multiple of 32 bytes", len(b))
+	}
+	p := make(MerkleProof, len(b)/32)
+	for i := range p {
+		copy(p[i][:], b[i*32:])
+	}
+	return p, nil
+}
+
+// MarshalBinary encodes the proof in binary
+func (p MerkleProof) MarshalBinary() ([]byte, error) {
+	return p.Bytes(), nil
+}
+
+// UnmarshalBinary decodes the proof from binary
+func (p *MerkleProof) UnmarshalBinary(b []byte) error {
+	proof, err := BytesToMerkleProof(b)
+	if err != nil {
+		return err
+	}
+	*p = proof
+	return nil
+}
+
+// MarshalJSON encodes the proof to JSON array of sibling hashes in hex string
+func (p MerkleProof) MarshalJSON() ([]byte, error) {
+	s := make([]string, len(p))
+	for i := range p {
+		s[i] = hex.EncodeToString(p[i][:])
+	}
+	return json.Marshal(s)
+}
+
+// UnmarshalJSON decodes the proof from JSON array of hex string, with or without
+// 0x prefix
+func (p *MerkleProof) UnmarshalJSON(data []byte) error {
+	var s []string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	proof := make(MerkleProof, len(s))
+	for i := range s {
+		b, err := hex.DecodeString(util.Remove0xPrefix(s[i]))
+		if err != nil {
+			return errors.Wrapf(err, "failed to decode hash at index %d", i)
+		}
+		if len(b) != 32 {
+			return errors.Errorf("invalid hash length %d at index %d, need 32 bytes", len(b), i)
+		}
+		copy(proof[i][:], b)
+	}
+	*p = proof
+	return nil
+}
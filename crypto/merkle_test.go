@@ -8,9 +8,11 @@ package crypto
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/iotexproject/go-pkgs/hash"
 )
@@ -41,3 +43,102 @@ func TestMerkleTree(t *testing.T) {
 	rootHashHex := hex.EncodeToString(rootHash[:])
 	assert.Equal(t, "4de26a6d1d6618f7bfeb3d168e37ef645db94c2d558bf8c3546d1311877ddffa", rootHashHex)
 }
+
+func TestMerkleProof(t *testing.T) {
+	require := require.New(t)
+
+	var leaves []hash.Hash256
+	for size := 1; size <= 17; size++ {
+		leaves = append(leaves, hash.Hash256b([]byte{byte(size)}))
+		m := NewMerkleTree(leaves)
+		root := m.HashTree()
+		for i := range leaves {
+			proof, err := m.Proof(i)
+			require.NoError(err)
+			require.True(VerifyProof(root, leaves[i], i, proof))
+			require.True(VerifyProofWithSize(root, leaves[i], i, size, proof))
+			require.False(VerifyProofWithSize(root, leaves[i], i, 2*size, proof))
+			if i+1 < size {
+				require.False(VerifyProof(root, leaves[i], i+1, proof))
+			}
+			require.False(VerifyProof(root, hash.ZeroHash256, i, proof))
+			if len(proof) > 0 {
+				proof[len(proof)-1][0] ^= 1
+				require.False(VerifyProof(root, leaves[i], i, proof))
+			}
+		}
+		_, err := m.Proof(size)
+		require.Error(err)
+		_, err = m.Proof(-1)
+		require.Error(err)
+	}
+	require.False(VerifyProof(leaves[0], leaves[0], -1, nil))
+
+	// single leaf is the root
+	m := NewMerkleTree(leaves[:1])
+	proof, err := m.Proof(0)
+	require.NoError(err)
+	require.Empty(proof)
+	require.True(VerifyProof(leaves[0], leaves[0], 0, proof))
+
+	// last leaf of odd size is paired with itself
+	m = NewMerkleTree(leaves[:5])
+	proof, err = m.Proof(4)
+	require.NoError(err)
+	require.Len(proof, 3)
+	require.Equal(leaves[4], proof[0])
+	require.Equal(proof[1], merkleParent(leaves[4], leaves[4]))
+	require.True(VerifyProof(m.HashTree(), leaves[4], 5, proof))
+	require.False(VerifyProofWithSize(m.HashTree(), leaves[4], 5, 5, proof))
+
+	// interior node is not a leaf, given the size of tree
+	m = NewMerkleTree(leaves[:8])
+	root := m.HashTree()
+	proof, err = m.Proof(6)
+	require.NoError(err)
+	node := merkleParent(leaves[6], leaves[7])
+	require.True(VerifyProof(root, node, 3, proof[1:]))
+	require.False(VerifyProofWithSize(root, node, 3, 8, proof[1:]))
+	require.False(VerifyProofWithSize(root, leaves[0], 0, 0, nil))
+}
+
+func TestMerkleProofEncoding(t *testing.T) {
+	require := require.New(t)
+
+	inputs := []hash.Hash256{
+		decodeHash("aeedd06eb44f08abbcc72a2293aff580f13662fa59cc1b0aa4a15ee7c118e4eb"),
+		decodeHash("9de6306b08158c423330f7a27243a1a5cbe39bfd764f07818437882d21241567"),
+		decodeHash("7959228bfdb316949973c08d8bb7bea2a21227a7b4ed85c35d247bf3d6b15a11"),
+	}
+	m := NewMerkleTree(inputs)
+	proof, err := m.Proof(1)
+	require.NoError(err)
+
+	b := proof.Bytes()
+	require.Len(b, 64)
+	require.Equal(inputs[0][:], b[:32])
+	p, err := BytesToMerkleProof(b)
+	require.NoError(err)
+	require.Equal(proof, p)
+	_, err = BytesToMerkleProof(b[1:])
+	require.Error(err)
+	p, err = BytesToMerkleProof(nil)
+	require.NoError(err)
+	require.Empty(p)
+
+	var pb MerkleProof
+	require.NoError(pb.UnmarshalBinary(b))
+	require.Equal(proof, pb)
+
+	js, err := json.Marshal(proof)
+	require.NoError(err)
+	require.Equal(`["aeedd06eb44f08abbcc72a2293aff580f13662fa59cc1b0aa4a15ee7c118e4eb","`+
+		hex.EncodeToString(proof[1][:])+`"]`, string(js))
+	var pj MerkleProof
+	require.NoError(json.Unmarshal(js, &pj))
+	require.Equal(proof, pj)
+	require.NoError(json.Unmarshal([]byte(`["0xaeedd06eb44f08abbcc72a2293aff580f13662fa59cc1b0aa4a15ee7c118e4eb"]`), &pj))
+	require.Equal(proof[:1], pj)
+	require.Error(json.Unmarshal([]byte(`["aeedd06e"]`), &pj))
+	require.Error(json.Unmarshal([]byte(`["zz"]`), &pj))
+}
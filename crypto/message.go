@@ -0,0 +1,94 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/signer/core/apitypes"
+	"github.com/pkg/errors"
+)
+
+// TypedData is the EIP-712 typed structured data
+type TypedData = apitypes.TypedData
+
+// HashPersonalMessage returns the EIP-191 hash of message, which is
+// keccak256("\x19Ethereum Signed Message:\n" + len(message) + message)
+func HashPersonalMessage(msg []byte) []byte {
+	return accounts.TextHash(msg)
+}
+
+// SignPersonalMessage signs the message per EIP-191 (personal_sign), and returns
+// the 65-byte (r, s, v) signature
+func SignPersonalMessage(sk PrivateKey, msg []byte) ([]byte, error) {
+	return signMessageHash(sk, HashPersonalMessage(msg))
+}
+
+// VerifyPersonalMessage verifies the EIP-191 signature of message
+func VerifyPersonalMessage(pk PublicKey, msg, sig []byte) bool {
+	return pk.Verify(HashPersonalMessage(msg), sig)
+}
+
+// RecoverPersonalMessage recovers the public key from EIP-191 signature of message
+func RecoverPersonalMessage(msg, sig []byte) (PublicKey, error) {
+	return RecoverPubkey(HashPersonalMessage(msg), sig)
+}
+
+// HashTypedData returns the EIP-712 hash of typed data, which is
+// keccak256("\x19\x01" + domainSeparator + hashStruct(message))
+func HashTypedData(data *TypedData) ([]byte, error) {
+	h, _, err := apitypes.TypedDataAndHash(*data)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to hash typed data")
+	}
+	return h, nil
+}
+
+// SignTypedData signs the typed data per EIP-712 (eth_signTypedData_v4), and
+// returns the 65-byte (r, s, v) signature
+func SignTypedData(sk PrivateKey, data *TypedData) ([]byte, error) {
+	h, err := HashTypedData(data)
+	if err != nil {
+		return nil, err
+	}
+	return signMessageHash(sk, h)
+}
+
+// VerifyTypedData verifies the EIP-712 signature of typed data
+func VerifyTypedData(pk PublicKey, data *TypedData, sig []byte) bool {
+	h, err := HashTypedData(data)
+	if err != nil {
+		return false
+	}
+	return pk.Verify(h, sig)
+}
+
+// RecoverTypedData recovers the public key from EIP-712 signature of typed data
+func RecoverTypedData(data *TypedData, sig []byte) (PublicKey, error) {
+	h, err := HashTypedData(data)
+	if err != nil {
+		return nil, err
+	}
+	return RecoverPubkey(h, sig)
+}
+
+// signMessageHash signs the hash into recoverable 65-byte signature, for secp256k1
+// key 27 is added to the recovery id as wallets like MetaMask do
+func signMessageHash(sk PrivateKey, h []byte) ([]byte, error) {
+	switch k := sk.(type) {
+	case *secp256k1PrvKey:
+		sig, err := k.Sign(h)
+		if err != nil {
+			return nil, err
+		}
+		sig[Secp256k1SigSize] += 27
+		return sig, nil
+	case *P256sm2PrvKey:
+		return k.SignWithOptions(h, WithRecoveryID())
+	default:
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported private key type %T", sk)
+	}
+}
@@ -0,0 +1,109 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// the Mail example in EIP-712
+const _testTypedData = `{
+	"types": {
+		"EIP712Domain": [
+			{"name": "name", "type": "string"},
+			{"name": "version", "type": "string"},
+			{"name": "chainId", "type": "uint256"},
+			{"name": "verifyingContract", "type": "address"}
+		],
+		"Person": [
+			{"name": "name", "type": "string"},
+			{"name": "wallet", "type": "address"}
+		],
+		"Mail": [
+			{"name": "from", "type": "Person"},
+			{"name": "to", "type": "Person"},
+			{"name": "contents", "type": "string"}
+		]
+	},
+	"primaryType": "Mail",
+	"domain": {
+		"name": "Ether Mail",
+		"version": "1",
+		"chainId": 1,
+		"verifyingContract": "0xCcCCccccCCCCcCCCCCCcCcCccCcCCCcCcccccccC"
+	},
+	"message": {
+		"from": {"name": "Cow", "wallet": "0xCD2a3d9F938E13CD947Ec05AbC7FE734Df8DD826"},
+		"to": {"name": "Bob", "wallet": "0xbBbBBBBbbBBBbbbBbbBbbbbBBbBbbbbBbBbbBBbB"},
+		"contents": "Hello, Bob!"
+	}
+}`
+
+func TestPersonalMessage(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal("a1de988600a42c4b4ab089b619297c17d53cffae5d5120d82d8a92d0bb3b78f2",
+		hex.EncodeToString(HashPersonalMessage([]byte("Hello World"))))
+
+	sk1, err := GenerateKey()
+	require.NoError(err)
+	sk2, err := GenerateKeySm2()
+	require.NoError(err)
+	msg := []byte("test personal message")
+	for _, sk := range []PrivateKey{sk1, sk2} {
+		sig, err := SignPersonalMessage(sk, msg)
+		require.NoError(err)
+		require.Equal(65, len(sig))
+		require.True(VerifyPersonalMessage(sk.PublicKey(), msg, sig))
+		require.False(VerifyPersonalMessage(sk.PublicKey(), msg[1:], sig))
+		pk, err := RecoverPersonalMessage(msg, sig)
+		require.NoError(err)
+		require.Equal(sk.PublicKey().Address(), pk.Address())
+	}
+	sig, err := SignPersonalMessage(sk1, msg)
+	require.NoError(err)
+	require.True(sig[64] == 27 || sig[64] == 28)
+}
+
+func TestTypedData(t *testing.T) {
+	require := require.New(t)
+
+	var data TypedData
+	require.NoError(json.Unmarshal([]byte(_testTypedData), &data))
+	h, err := HashTypedData(&data)
+	require.NoError(err)
+	require.Equal("be609aee343fb3c4b28e1df9e632fca64fcfaede20f02e86244efddf30957bd2", hex.EncodeToString(h))
+
+	// private key of "cow" = keccak256("cow")
+	sk, err := HexStringToPrivateKey("c85ef7d79691fe79573b1a7064c19c1a9819ebdbd1faaab1a8ec92344438aaf4")
+	require.NoError(err)
+	require.Equal("cd2a3d9f938e13cd947ec05abc7fe734df8dd826", hex.EncodeToString(sk.PublicKey().Hash()))
+	sig, err := SignTypedData(sk, &data)
+	require.NoError(err)
+	require.Equal("4355c47d63924e8a72e509b65029052eb6c299d53a04e167c5775fd466751c9d"+
+		"07299936d304c153f6443dfa05f40ff007d72911b6f72307f996231605b91562"+"1c", hex.EncodeToString(sig))
+	require.True(VerifyTypedData(sk.PublicKey(), &data, sig))
+	pk, err := RecoverTypedData(&data, sig)
+	require.NoError(err)
+	require.Equal(sk.PublicKey(), pk)
+
+	data.Message["contents"] = "Hello, Alice!"
+	require.False(VerifyTypedData(sk.PublicKey(), &data, sig))
+	pk, err = RecoverTypedData(&data, sig)
+	require.NoError(err)
+	require.NotEqual(sk.PublicKey(), pk)
+
+	data.PrimaryType = "Unknown"
+	_, err = HashTypedData(&data)
+	require.Error(err)
+	_, err = SignTypedData(sk, &data)
+	require.Error(err)
+}
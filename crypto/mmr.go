@@ -0,0 +1,216 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"math/bits"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+// MMR is the Merkle Mountain Range, an append-only accumulator of leaves
+//
+// the leaves are kept in perfect binary trees of decreasing sizes, called peaks,
+// and the root is computed by bagging the peaks from right to left, that is
+// H(peak0 || H(peak1 || ... H(peakN-1 || peakN))). This is the same tree shape as
+// RFC 6962, so inclusion and consistency proofs are verified with the algorithms of
+// RFC 9162. Parent node is hashed same as in Merkle, without domain separation, so
+// the root does not commit to the number of leaves, which must be obtained along
+// with the root from a trusted source
+//
+// the root of MMR differs from Merkle of the same leaves, unless the number of
+// leaves is a power of 2
+type MMR struct {
+	// nodes[h][i] is the root of the i-th perfect subtree of height h
+	nodes [][]hash.Hash256
+}
+
+// NewMMR creates an empty MMR
+func NewMMR() *MMR {
+	return &MMR{
+		nodes: [][]hash.Hash256{{}},
+	}
+}
+
+// Append appends the leaf and returns its index, it takes O(log n) time
+func (m *MMR) Append(leaf hash.Hash256) int {
+	index := len(m.nodes[0])
+	m.nodes[0] = append(m.nodes[0], leaf)
+	for h := 0; len(m.nodes[h])&1 == 0; h++ {
+		if h+1 == len(m.nodes) {
+			m.nodes = append(m.nodes, nil)
+		}
+		n := len(m.nodes[h])
+		m.nodes[h+1] = append(m.nodes[h+1], merkleParent(m.nodes[h][n-2], m.nodes[h][n-1]))
+	}
+	return index
+}
+
+// Size returns the number of leaves
+func (m *MMR) Size() int {
+	return len(m.nodes[0])
+}
+
+// Root returns the current root, which is ZeroHash256 for empty MMR
+func (m *MMR) Root() hash.Hash256 {
+	root, _ := m.RootAt(m.Size())
+	return root
+}
+
+// RootAt returns the root when MMR had size leaves
+func (m *MMR) RootAt(size int) (hash.Hash256, error) {
+	if err := m.checkSize(size); err != nil {
+		return hash.ZeroHash256, err
+	}
+	if size == 0 {
+		return hash.ZeroHash256, nil
+	}
+	return m.subtree(0, size), nil
+}
+
+// InclusionProof returns the proof that the leaf at index is in MMR of size leaves
+func (m *MMR) InclusionProof(index, size int) (MerkleProof, error) {
+	if err := m.checkSize(size); err != nil {
+		return nil, err
+	}
+	if index < 0 || index >= size {
+		return nil, errors.Errorf("index %d out of range [0, %d)", index, size)
+	}
+	return m.path(index, 0, size), nil
+}
+
+// ConsistencyProof returns the proof that MMR of oldSize leaves is a prefix of MMR
+// of newSize leaves
+func (m *MMR) ConsistencyProof(oldSize, newSize int) (MerkleProof, error) {
+	if err := m.checkSize(newSize); err != nil {
+		return nil, err
+	}
+	if oldSize <= 0 || oldSize > newSize {
+		return nil, errors.Errorf("old size %d out of range [1, %d]", oldSize, newSize)
+	}
+	return m.subproof(oldSize, 0, newSize, true), nil
+}
+
+func (m *MMR) checkSize(size int) error {
+	if size < 0 || size > m.Size() {
+		return errors.Errorf("size %d out of range [0, %d]", size, m.Size())
+	}
+	return nil
+}
+
+// subtree returns the root of leaves [a, b), a is aligned to the largest perfect
+// subtree in it
+func (m *MMR) subtree(a, b int) hash.Hash256 {
+	n := b - a
+	if n&(n-1) == 0 {
+		h := bits.TrailingZeros(uint(n))
+		return m.nodes[h][a>>h]
+	}
+	k := splitSize(n)
+	return merkleParent(m.subtree(a, a+k), m.subtree(a+k, b))
+}
+
+// path is PATH(index, D[a:b]) of RFC 6962
+func (m *MMR) path(index, a, b int) MerkleProof {
+	if b-a == 1 {
+		return MerkleProof{}
+	}
+	k := splitSize(b - a)
+	if index < a+k {
+		return append(m.path(index, a, a+k), m.subtree(a+k, b))
+	}
+	return append(m.path(index, a+k, b), m.subtree(a, a+k))
+}
+
+// subproof is SUBPROOF(old, D[a:b], complete) of RFC 6962
+func (m *MMR) subproof(old, a, b int, complete bool) MerkleProof {
+	if old == b {
+		if complete {
+			return MerkleProof{}
+		}
+		return MerkleProof{m.subtree(a, b)}
+	}
+	k := splitSize(b - a)
+	if old <= a+k {
+		return append(m.subproof(old, a, a+k, complete), m.subtree(a+k, b))
+	}
+	return append(m.subproof(old, a+k, b, false), m.subtree(a, a+k))
+}
+
+// VerifyMMRInclusion verifies the proof that leaf is at index of MMR of size leaves
+// and root
+func VerifyMMRInclusion(root, leaf hash.Hash256, index, size int, proof MerkleProof) bool {
+	if index < 0 || index >= size {
+		return false
+	}
+	fn, sn, r := index, size-1, leaf
+	for _, p := range proof {
+		if sn == 0 {
+			return false
+		}
+		if fn&1 == 1 || fn == sn {
+			r = merkleParent(p, r)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			r = merkleParent(r, p)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	return sn == 0 && r == root
+}
+
+// VerifyMMRConsistency verifies the proof that MMR of oldSize leaves and oldRoot is
+// a prefix of MMR of newSize leaves and newRoot
+func VerifyMMRConsistency(oldRoot, newRoot hash.Hash256, oldSize, newSize int, proof MerkleProof) bool {
+	if oldSize <= 0 || oldSize > newSize {
+		return false
+	}
+	if oldSize == newSize {
+		return len(proof) == 0 && oldRoot == newRoot
+	}
+	if len(proof) == 0 {
+		return false
+	}
+	if oldSize&(oldSize-1) == 0 {
+		proof = append(MerkleProof{oldRoot}, proof...)
+	}
+	fn, sn := oldSize-1, newSize-1
+	for fn&1 == 1 {
+		fn >>= 1
+		sn >>= 1
+	}
+	fr, sr := proof[0], proof[0]
+	for _, c := range proof[1:] {
+		if sn == 0 {
+			return false
+		}
+		if fn&1 == 1 || fn == sn {
+			fr = merkleParent(c, fr)
+			sr = merkleParent(c, sr)
+			for fn&1 == 0 && fn != 0 {
+				fn >>= 1
+				sn >>= 1
+			}
+		} else {
+			sr = merkleParent(sr, c)
+		}
+		fn >>= 1
+		sn >>= 1
+	}
+	return sn == 0 && fr == oldRoot && sr == newRoot
+}
+
+// splitSize returns the largest power of 2 smaller than n, n > 1
+func splitSize(n int) int {
+	return 1 << (bits.Len(uint(n-1)) - 1)
+}
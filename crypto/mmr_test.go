@@ -0,0 +1,133 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"math/bits"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+func TestMMR(t *testing.T) {
+	require := require.New(t)
+
+	m := NewMMR()
+	require.Zero(m.Size())
+	require.Equal(hash.ZeroHash256, m.Root())
+
+	var (
+		leaves []hash.Hash256
+		roots  = []hash.Hash256{hash.ZeroHash256}
+	)
+	for i := 0; i < 70; i++ {
+		leaf := hash.Hash256b([]byte{byte(i)})
+		require.Equal(i, m.Append(leaf))
+		leaves = append(leaves, leaf)
+		roots = append(roots, m.Root())
+		require.Equal(i+1, m.Size())
+
+		// root is the bagged peaks
+		var peaks []hash.Hash256
+		for start, n := 0, i+1; n > 0; {
+			k := 1 << (bits.Len(uint(n)) - 1)
+			peaks = append(peaks, NewMerkleTree(leaves[start:start+k]).HashTree())
+			start += k
+			n -= k
+		}
+		bagged := peaks[len(peaks)-1]
+		for j := len(peaks) - 2; j >= 0; j-- {
+			bagged = merkleParent(peaks[j], bagged)
+		}
+		require.Equal(bagged, m.Root())
+	}
+	// same as Merkle for power of 2
+	require.Equal(NewMerkleTree(leaves[:64]).HashTree(), roots[64])
+
+	// historical roots
+	for size := range roots {
+		root, err := m.RootAt(size)
+		require.NoError(err)
+		require.Equal(roots[size], root)
+	}
+	_, err := m.RootAt(71)
+	require.Error(err)
+	_, err = m.RootAt(-1)
+	require.Error(err)
+}
+
+func TestMMRInclusionProof(t *testing.T) {
+	require := require.New(t)
+
+	m := NewMMR()
+	var leaves []hash.Hash256
+	for i := 0; i < 40; i++ {
+		leaves = append(leaves, hash.Hash256b([]byte{byte(i)}))
+		m.Append(leaves[i])
+	}
+	for size := 1; size <= m.Size(); size++ {
+		root, err := m.RootAt(size)
+		require.NoError(err)
+		for i := 0; i < size; i++ {
+			proof, err := m.InclusionProof(i, size)
+			require.NoError(err)
+			require.True(VerifyMMRInclusion(root, leaves[i], i, size, proof))
+			require.False(VerifyMMRInclusion(root, hash.ZeroHash256, i, size, proof))
+			if size > 1 {
+				require.False(VerifyMMRInclusion(root, leaves[i], i^1, size, proof))
+				require.False(VerifyMMRInclusion(root, leaves[i], i, size, proof[1:]))
+				require.False(VerifyMMRInclusion(root, leaves[i], i, size, append(proof, root)))
+				proof[0][0] ^= 1
+				require.False(VerifyMMRInclusion(root, leaves[i], i, size, proof))
+			}
+		}
+		_, err = m.InclusionProof(size, size)
+		require.Error(err)
+	}
+	_, err := m.InclusionProof(0, 41)
+	require.Error(err)
+	require.False(VerifyMMRInclusion(leaves[0], leaves[0], -1, 1, nil))
+	require.True(VerifyMMRInclusion(leaves[0], leaves[0], 0, 1, nil))
+}
+
+func TestMMRConsistencyProof(t *testing.T) {
+	require := require.New(t)
+
+	m := NewMMR()
+	for i := 0; i < 40; i++ {
+		m.Append(hash.Hash256b([]byte{byte(i)}))
+	}
+	for newSize := 1; newSize <= m.Size(); newSize++ {
+		newRoot, err := m.RootAt(newSize)
+		require.NoError(err)
+		for oldSize := 1; oldSize <= newSize; oldSize++ {
+			oldRoot, err := m.RootAt(oldSize)
+			require.NoError(err)
+			proof, err := m.ConsistencyProof(oldSize, newSize)
+			require.NoError(err)
+			require.True(VerifyMMRConsistency(oldRoot, newRoot, oldSize, newSize, proof))
+			if oldSize == newSize {
+				require.Empty(proof)
+				continue
+			}
+			require.False(VerifyMMRConsistency(newRoot, newRoot, oldSize, newSize, proof))
+			require.False(VerifyMMRConsistency(oldRoot, oldRoot, oldSize, newSize, proof))
+			require.False(VerifyMMRConsistency(oldRoot, newRoot, oldSize, newSize, proof[1:]))
+			proof[len(proof)-1][0] ^= 1
+			require.False(VerifyMMRConsistency(oldRoot, newRoot, oldSize, newSize, proof))
+		}
+	}
+	_, err := m.ConsistencyProof(0, 10)
+	require.Error(err)
+	_, err = m.ConsistencyProof(11, 10)
+	require.Error(err)
+	_, err = m.ConsistencyProof(10, 41)
+	require.Error(err)
+	require.False(VerifyMMRConsistency(hash.ZeroHash256, hash.ZeroHash256, 0, 0, nil))
+}
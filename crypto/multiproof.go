@@ -0,0 +1,216 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"bytes"
+	"encoding/binary"
+	"math"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+// MerkleMultiproof is the inclusion proof of multiple leaves of a merkle tree
+//
+// the hashes are the nodes which cannot be computed from the proven leaves, level
+// by level from leaves to root, and from left to right in each level. A node shared
+// by the paths of several leaves is included only once
+type MerkleMultiproof struct {
+	Size    int
+	Indices []int
+	Hashes  []hash.Hash256
+}
+
+// Multiproof returns the inclusion proof of the leaves at indices, the indices are
+// sorted and deduplicated in the proof
+func (mk *Merkle) Multiproof(indices []int) (*MerkleMultiproof, error) {
+	if len(indices) == 0 {
+		return nil, errors.New("no index to prove")
+	}
+	sorted := append([]int(nil), indices...)
+	sort.Ints(sorted)
+	known := sorted[:0]
+	for i, index := range sorted {
+		if index < 0 || index >= mk.count {
+			return nil, errors.Errorf("index %d out of range [0, %d)", index, mk.count)
+		}
+		if i == 0 || index != sorted[i-1] {
+			known = append(known, index)
+		}
+	}
+
+	mp := &MerkleMultiproof{
+		Size:    mk.count,
+		Indices: append([]int(nil), known...),
+	}
+	levels := mk.levels()
+	for _, level := range levels[:len(levels)-1] {
+		parents := known[:0]
+		for i := 0; i < len(known); i++ {
+			index, sibling := known[i], known[i]^1
+			switch {
+			case sibling == len(level):
+				// last node of odd level is paired with itself
+			case i+1 < len(known) && known[i+1] == sibling:
+				i++
+			default:
+				mp.Hashes = append(mp.Hashes, level[sibling])
+			}
+			parents = append(parents, index>>1)
+		}
+		known = parents
+	}
+	return mp, nil
+}
+
+// VerifyMultiproof verifies the proof that leaves are in the merkle tree of root,
+// the leaves must be in the same order as proof.Indices
+//
+// proof.Size comes with the proof and is not committed to by the root, a proof of a
+// tree of odd size can claim one more leaf, which is the padded copy of last leaf.
+// Use VerifyMultiproofWithSize if the number of leaves is known, or check proof.Size
+// against it
+func VerifyMultiproof(root hash.Hash256, leaves []hash.Hash256, proof *MerkleMultiproof) bool {
+	if proof == nil || proof.Size <= 0 || len(proof.Indices) == 0 || len(leaves) != len(proof.Indices) {
+		return false
+	}
+	for i, index := range proof.Indices {
+		if index < 0 || index >= proof.Size || (i > 0 && index <= proof.Indices[i-1]) {
+			return false
+		}
+	}
+
+	var (
+		known  = append([]int(nil), proof.Indices...)
+		nodes  = append([]hash.Hash256(nil), leaves...)
+		hashes = proof.Hashes
+	)
+	for width := proof.Size; width > 1; width = (width + 1) >> 1 {
+		n := 0
+		for i := 0; i < len(known); i++ {
+			index, node := known[i], nodes[i]
+			var sibling hash.Hash256
+			switch {
+			case index^1 == width:
+				sibling = node
+			case i+1 < len(known) && known[i+1] == index^1:
+				sibling = nodes[i+1]
+				i++
+			default:
+				if len(hashes) == 0 {
+					return false
+				}
+				sibling, hashes = hashes[0], hashes[1:]
+			}
+			if index&1 == 0 {
+				nodes[n] = merkleParent(node, sibling)
+			} else {
+				nodes[n] = merkleParent(sibling, node)
+			}
+			known[n] = index >> 1
+			n++
+		}
+		known, nodes = known[:n], nodes[:n]
+	}
+	return len(hashes) == 0 && nodes[0] == root
+}
+
+// VerifyMultiproofWithSize verifies the proof that leaves are in the merkle tree of
+// root with size leaves, which proof.Size must equal
+func VerifyMultiproofWithSize(root hash.Hash256, leaves []hash.Hash256, size int, proof *MerkleMultiproof) bool {
+	if proof == nil || proof.Size != size {
+		return false
+	}
+	return VerifyMultiproof(root, leaves, proof)
+}
+
+// Bytes returns the proof in binary, which is the unsigned varint of size, number
+// of indices and each index, followed by the concatenation of hashes
+//
+// the encoding is deterministic, a proof has only one valid encoding
+func (mp *MerkleMultiproof) Bytes() []byte {
+	b := make([]byte, 0, binary.MaxVarintLen64*(len(mp.Indices)+2)+len(mp.Hashes)*32)
+	b = binary.AppendUvarint(b, uint64(mp.Size))
+	b = binary.AppendUvarint(b, uint64(len(mp.Indices)))
+	for _, index := range mp.Indices {
+		b = binary.AppendUvarint(b, uint64(index))
+	}
+	for i := range mp.Hashes {
+		b = append(b, mp.Hashes[i][:]...)
+	}
+	return b
+}
+
+// BytesToMerkleMultiproof decodes the proof encoded by MerkleMultiproof.Bytes
+func BytesToMerkleMultiproof(b []byte) (*MerkleMultiproof, error) {
+	var (
+		rest = b
+		uv   = func() (int, error) {
+			v, n := binary.Uvarint(rest)
+			if n <= 0 || v > math.MaxInt32 {
+				return 0, errors.New("invalid multiproof encoding")
+			}
+			rest = rest[n:]
+			return int(v), nil
+		}
+	)
+	size, err := uv()
+	if err != nil {
+		return nil, err
+	}
+	count, err := uv()
+	if err != nil {
+		return nil, err
+	}
+	if count > size || count > len(rest) {
+		return nil, errors.Errorf("invalid number of indices %d", count)
+	}
+	mp := &MerkleMultiproof{
+		Size:    size,
+		Indices: make([]int, count),
+	}
+	for i := range mp.Indices {
+		if mp.Indices[i], err = uv(); err != nil {
+			return nil, err
+		}
+		if mp.Indices[i] >= size || (i > 0 && mp.Indices[i] <= mp.Indices[i-1]) {
+			return nil, errors.Errorf("invalid index %d", mp.Indices[i])
+		}
+	}
+	if len(rest)%32 != 0 {
+		return nil, errors.Errorf("invalid hashes length %d, need multiple of 32 bytes", len(rest))
+	}
+	if len(rest) > 0 {
+		mp.Hashes = make([]hash.Hash256, len(rest)/32)
+		for i := range mp.Hashes {
+			copy(mp.Hashes[i][:], rest[i*32:])
+		}
+	}
+	// reject non-minimal varint
+	if !bytes.Equal(mp.Bytes(), b) {
+		return nil, errors.New("non-canonical multiproof encoding")
+	}
+	return mp, nil
+}
+
+// MarshalBinary encodes the proof in binary
+func (mp *MerkleMultiproof) MarshalBinary() ([]byte, error) {
+	return mp.Bytes(), nil
+}
+
+// UnmarshalBinary decodes the proof from binary
+func (mp *MerkleMultiproof) UnmarshalBinary(b []byte) error {
+	proof, err := BytesToMerkleMultiproof(b)
+	if err != nil {
+		return err
+	}
+	*mp = *proof
+	return nil
+}
@@ -0,0 +1,148 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+func TestMerkleMultiproof(t *testing.T) {
+	require := require.New(t)
+
+	var leaves []hash.Hash256
+	for size := 1; size <= 33; size++ {
+		leaves = append(leaves, hash.Hash256b([]byte{byte(size)}))
+		m := NewMerkleTree(leaves)
+		root := m.HashTree()
+		for trial := 0; trial < 10; trial++ {
+			var indices []int
+			for i := rand.Intn(size) + 1; i > 0; i-- {
+				indices = append(indices, rand.Intn(size))
+			}
+			mp, err := m.Multiproof(indices)
+			require.NoError(err)
+			require.Equal(size, mp.Size)
+			proven := make([]hash.Hash256, len(mp.Indices))
+			for i, index := range mp.Indices {
+				proven[i] = leaves[index]
+				if i > 0 {
+					require.Less(mp.Indices[i-1], index)
+				}
+			}
+			require.True(VerifyMultiproof(root, proven, mp))
+			require.True(VerifyMultiproofWithSize(root, proven, size, mp))
+			require.False(VerifyMultiproofWithSize(root, proven, size+1, mp))
+
+			// not larger than the single proofs
+			single := 0
+			for _, index := range mp.Indices {
+				p, err := m.Proof(index)
+				require.NoError(err)
+				single += len(p)
+			}
+			require.LessOrEqual(len(mp.Hashes), single)
+
+			// tampered leaf or hash
+			proven[0][0] ^= 1
+			require.False(VerifyMultiproof(root, proven, mp))
+			proven[0][0] ^= 1
+			if len(mp.Hashes) > 0 {
+				mp.Hashes[0][0] ^= 1
+				require.False(VerifyMultiproof(root, proven, mp))
+				mp.Hashes[0][0] ^= 1
+				mp.Hashes = mp.Hashes[1:]
+				require.False(VerifyMultiproof(root, proven, mp))
+			}
+		}
+		_, err := m.Multiproof([]int{0, size})
+		require.Error(err)
+		_, err = m.Multiproof([]int{-1})
+		require.Error(err)
+	}
+
+	m := NewMerkleTree(leaves[:8])
+	root := m.HashTree()
+	_, err := m.Multiproof(nil)
+	require.Error(err)
+
+	// shared nodes are deduplicated, all leaves need no hash
+	mp, err := m.Multiproof([]int{7, 6, 5, 4, 3, 2, 1, 0, 0})
+	require.NoError(err)
+	require.Equal([]int{0, 1, 2, 3, 4, 5, 6, 7}, mp.Indices)
+	require.Empty(mp.Hashes)
+	require.True(VerifyMultiproof(root, leaves[:8], mp))
+	mp, err = m.Multiproof([]int{0, 1, 2})
+	require.NoError(err)
+	require.Equal([]hash.Hash256{leaves[3], merkleParent(merkleParent(leaves[4], leaves[5]), merkleParent(leaves[6], leaves[7]))}, mp.Hashes)
+
+	// invalid proof
+	require.False(VerifyMultiproof(root, leaves[:3], nil))
+	require.False(VerifyMultiproof(root, leaves[:2], mp))
+	mp.Indices = []int{0, 2, 1}
+	require.False(VerifyMultiproof(root, leaves[:3], mp))
+	mp.Indices = []int{0, 1, 2}
+	mp.Size = 9
+	require.False(VerifyMultiproof(root, leaves[:3], mp))
+	require.False(VerifyMultiproofWithSize(root, leaves[:3], 8, nil))
+
+	// padded copy of last leaf is not a leaf, given the size of tree
+	m = NewMerkleTree(leaves[:3])
+	forged := &MerkleMultiproof{
+		Size:    4,
+		Indices: []int{0, 1, 2, 3},
+	}
+	padded := []hash.Hash256{leaves[0], leaves[1], leaves[2], leaves[2]}
+	require.True(VerifyMultiproof(m.HashTree(), padded, forged))
+	require.False(VerifyMultiproofWithSize(m.HashTree(), padded, 3, forged))
+}
+
+func TestMerkleMultiproofEncoding(t *testing.T) {
+	require := require.New(t)
+
+	var leaves []hash.Hash256
+	for i := 0; i < 300; i++ {
+		leaves = append(leaves, hash.Hash256b([]byte{byte(i), byte(i >> 8)}))
+	}
+	m := NewMerkleTree(leaves)
+	mp, err := m.Multiproof([]int{299, 3, 150})
+	require.NoError(err)
+	b := mp.Bytes()
+	require.Equal([]byte{0xac, 0x02, 3, 3, 0x96, 0x01, 0xab, 0x02}, b[:8])
+	require.Equal(8+32*len(mp.Hashes), len(b))
+
+	mp2, err := BytesToMerkleMultiproof(b)
+	require.NoError(err)
+	require.Equal(mp, mp2)
+	require.Equal(b, mp2.Bytes())
+	require.True(VerifyMultiproof(m.HashTree(), []hash.Hash256{leaves[3], leaves[150], leaves[299]}, mp2))
+
+	var mp3 MerkleMultiproof
+	require.NoError(mp3.UnmarshalBinary(b))
+	require.Equal(mp, &mp3)
+
+	for _, v := range [][]byte{
+		nil,
+		b[:7],
+		b[:len(b)-1],
+		// index out of order
+		{5, 2, 3, 1},
+		// index out of range
+		{5, 1, 5},
+		// too many indices
+		{2, 3, 0, 1, 2},
+		// non-minimal varint
+		{0x85, 0x00, 1, 0},
+	} {
+		_, err = BytesToMerkleMultiproof(v)
+		require.Error(err)
+	}
+}
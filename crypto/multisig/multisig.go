@@ -0,0 +1,257 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+// Package multisig implements M-of-N multisignature accounts over crypto.PublicKey,
+// the account is valid for a hash if at least M distinct keys of the account signed it
+package multisig
+
+import (
+	"bytes"
+	"encoding/binary"
+	"sort"
+
+	"github.com/iotexproject/iotex-address/address"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+const (
+	// MaxKeys is the maximum number of keys in an account
+	MaxKeys = 255
+
+	// account is version(1) || threshold(1) || number of keys(1) || (uvarint length || tagged key) * n
+	accountVersion = 0x01
+)
+
+var (
+	// ErrInvalidAccount indicates the threshold or keys of account are invalid
+	ErrInvalidAccount = errors.New("invalid multisig account")
+	// ErrUnauthorizedSigner indicates the signer is not a key of the account
+	ErrUnauthorizedSigner = errors.New("signer is not authorized")
+	// ErrDuplicateSigner indicates a key signed more than once
+	ErrDuplicateSigner = errors.New("duplicate signer")
+	// ErrInvalidSignature indicates the signature fails verification
+	ErrInvalidSignature = errors.New("invalid signature")
+	// ErrThresholdNotMet indicates the number of valid signatures is less than threshold
+	ErrThresholdNotMet = errors.New("threshold not met")
+)
+
+type (
+	// Account is an M-of-N multisig account, whose keys are sorted by their tagged
+	// encoding, so the same set of keys and threshold always derive the same account
+	Account struct {
+		threshold int
+		keys      []crypto.PublicKey
+		ids       [][]byte
+		index     map[string]int
+	}
+
+	// PartialSig is the signature of one key of the account
+	PartialSig struct {
+		PubKey crypto.PublicKey
+		Sig    []byte
+	}
+
+	// Collector collects the partial signatures of a hash, each one is verified
+	// once added
+	Collector struct {
+		account *Account
+		hash    []byte
+		sigs    map[int][]byte
+	}
+)
+
+// NewAccount creates the account of threshold out of the keys, in any order
+func NewAccount(threshold int, keys []crypto.PublicKey) (*Account, error) {
+	if len(keys) == 0 || len(keys) > MaxKeys || threshold < 1 || threshold > len(keys) {
+		return nil, errors.Wrapf(ErrInvalidAccount, "threshold %d of %d keys", threshold, len(keys))
+	}
+	type entry struct {
+		id []byte
+		pk crypto.PublicKey
+	}
+	entries := make([]entry, len(keys))
+	for i, pk := range keys {
+		id, err := crypto.EncodePublicKey(pk)
+		if err != nil {
+			return nil, errors.Wrap(ErrInvalidAccount, err.Error())
+		}
+		entries[i] = entry{id, pk}
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		return bytes.Compare(entries[i].id, entries[j].id) < 0
+	})
+
+	a := &Account{
+		threshold: threshold,
+		keys:      make([]crypto.PublicKey, len(entries)),
+		ids:       make([][]byte, len(entries)),
+		index:     make(map[string]int, len(entries)),
+	}
+	for i, e := range entries {
+		if _, ok := a.index[string(e.id)]; ok {
+			return nil, errors.Wrapf(ErrInvalidAccount, "duplicate key %x", e.id)
+		}
+		a.keys[i], a.ids[i] = e.pk, e.id
+		a.index[string(e.id)] = i
+	}
+	return a, nil
+}
+
+// NewAccountFromBytes decodes the account encoded by Bytes
+func NewAccountFromBytes(b []byte) (*Account, error) {
+	if len(b) < 3 || b[0] != accountVersion {
+		return nil, errors.Wrap(ErrInvalidAccount, "invalid header")
+	}
+	threshold, n := int(b[1]), int(b[2])
+	b = b[3:]
+	keys := make([]crypto.PublicKey, 0, n)
+	for i := 0; i < n; i++ {
+		l, size := binary.Uvarint(b)
+		if size <= 0 || uint64(len(b)-size) < l {
+			return nil, errors.Wrapf(ErrInvalidAccount, "invalid key %d", i)
+		}
+		pk, err := crypto.DecodePublicKey(b[size : size+int(l)])
+		if err != nil {
+			return nil, errors.Wrapf(ErrInvalidAccount, "invalid key %d: %v", i, err)
+		}
+		keys = append(keys, pk)
+		b = b[size+int(l):]
+	}
+	if len(b) > 0 {
+		return nil, errors.Wrap(ErrInvalidAccount, "trailing data")
+	}
+	return NewAccount(threshold, keys)
+}
+
+// Threshold returns the number of signatures needed
+func (a *Account) Threshold() int {
+	return a.threshold
+}
+
+// PublicKeys returns the sorted keys of the account
+func (a *Account) PublicKeys() []crypto.PublicKey {
+	return append([]crypto.PublicKey{}, a.keys...)
+}
+
+// Contains returns true if the key belongs to the account
+func (a *Account) Contains(pk crypto.PublicKey) bool {
+	_, err := a.indexOf(pk)
+	return err == nil
+}
+
+// Bytes returns the canonical encoding of the account
+func (a *Account) Bytes() []byte {
+	b := []byte{accountVersion, byte(a.threshold), byte(len(a.keys))}
+	for _, id := range a.ids {
+		b = binary.AppendUvarint(b, uint64(len(id)))
+		b = append(b, id...)
+	}
+	return b
+}
+
+// Hash is the last 20-byte of keccak hash of the account encoding
+func (a *Account) Hash() []byte {
+	h := hash.Hash160b(a.Bytes())
+	return h[:]
+}
+
+// Address returns the address of the account
+func (a *Account) Address() address.Address {
+	addr, _ := address.FromBytes(a.Hash())
+	return addr
+}
+
+// Verify verifies that at least threshold distinct keys of the account signed the
+// hash, it fails on any unauthorized signer, duplicate signer or invalid signature
+func (a *Account) Verify(hash []byte, sigs []PartialSig) error {
+	signed := make(map[int]bool, len(sigs))
+	for _, s := range sigs {
+		i, err := a.verifyPartial(hash, s.PubKey, s.Sig)
+		if err != nil {
+			return err
+		}
+		if signed[i] {
+			return errors.Wrapf(ErrDuplicateSigner, "key %x", a.ids[i])
+		}
+		signed[i] = true
+	}
+	if len(signed) < a.threshold {
+		return errors.Wrapf(ErrThresholdNotMet, "need %d signatures, got %d", a.threshold, len(signed))
+	}
+	return nil
+}
+
+// NewCollector creates a collector of partial signatures of the hash
+func (a *Account) NewCollector(hash []byte) *Collector {
+	return &Collector{
+		account: a,
+		hash:    append([]byte{}, hash...),
+		sigs:    make(map[int][]byte),
+	}
+}
+
+func (a *Account) indexOf(pk crypto.PublicKey) (int, error) {
+	if pk == nil {
+		return 0, errors.Wrap(ErrUnauthorizedSigner, "nil key")
+	}
+	id, err := crypto.EncodePublicKey(pk)
+	if err != nil {
+		return 0, errors.Wrap(ErrUnauthorizedSigner, err.Error())
+	}
+	i, ok := a.index[string(id)]
+	if !ok {
+		return 0, errors.Wrapf(ErrUnauthorizedSigner, "key %x", id)
+	}
+	return i, nil
+}
+
+func (a *Account) verifyPartial(hash []byte, pk crypto.PublicKey, sig []byte) (int, error) {
+	i, err := a.indexOf(pk)
+	if err != nil {
+		return 0, err
+	}
+	if !a.keys[i].Verify(hash, sig) {
+		return 0, errors.Wrapf(ErrInvalidSignature, "key %x", a.ids[i])
+	}
+	return i, nil
+}
+
+// Add verifies and adds the partial signature
+func (c *Collector) Add(pk crypto.PublicKey, sig []byte) error {
+	i, err := c.account.verifyPartial(c.hash, pk, sig)
+	if err != nil {
+		return err
+	}
+	if _, ok := c.sigs[i]; ok {
+		return errors.Wrapf(ErrDuplicateSigner, "key %x", c.account.ids[i])
+	}
+	c.sigs[i] = append([]byte{}, sig...)
+	return nil
+}
+
+// Count returns the number of collected signatures
+func (c *Collector) Count() int {
+	return len(c.sigs)
+}
+
+// Complete returns true if enough signatures are collected
+func (c *Collector) Complete() bool {
+	return len(c.sigs) >= c.account.threshold
+}
+
+// Signatures returns the collected signatures, in the order of account keys
+func (c *Collector) Signatures() []PartialSig {
+	sigs := make([]PartialSig, 0, len(c.sigs))
+	for i, pk := range c.account.keys {
+		if sig, ok := c.sigs[i]; ok {
+			sigs = append(sigs, PartialSig{pk, sig})
+		}
+	}
+	return sigs
+}
@@ -0,0 +1,124 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package multisig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+func TestAccount(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		sks  []crypto.PrivateKey
+		pks  []crypto.PublicKey
+		gens = []func() (crypto.PrivateKey, error){crypto.GenerateKey, crypto.GenerateKeySm2, crypto.GenerateKey}
+	)
+	for _, gen := range gens {
+		sk, err := gen()
+		require.NoError(err)
+		sks = append(sks, sk)
+		pks = append(pks, sk.PublicKey())
+	}
+	a, err := NewAccount(2, pks)
+	require.NoError(err)
+	require.Equal(2, a.Threshold())
+	require.Len(a.PublicKeys(), 3)
+	require.NotNil(a.Address())
+	require.Len(a.Hash(), 20)
+
+	// deterministic regardless of the order of keys
+	b, err := NewAccount(2, []crypto.PublicKey{pks[2], pks[0], pks[1]})
+	require.NoError(err)
+	require.Equal(a.Bytes(), b.Bytes())
+	require.Equal(a.Address().String(), b.Address().String())
+	c, err := NewAccountFromBytes(a.Bytes())
+	require.NoError(err)
+	require.Equal(a.Bytes(), c.Bytes())
+	// threshold is part of the account
+	b, err = NewAccount(3, pks)
+	require.NoError(err)
+	require.NotEqual(a.Address().String(), b.Address().String())
+
+	// invalid account
+	for _, v := range []struct {
+		threshold int
+		keys      []crypto.PublicKey
+	}{
+		{0, pks},
+		{4, pks},
+		{1, nil},
+		{2, []crypto.PublicKey{pks[0], pks[1], pks[0]}},
+	} {
+		_, err = NewAccount(v.threshold, v.keys)
+		require.ErrorIs(err, ErrInvalidAccount)
+	}
+	for _, v := range [][]byte{nil, {0x02, 1, 1}, a.Bytes()[:10], append(a.Bytes(), 0)} {
+		_, err = NewAccountFromBytes(v)
+		require.ErrorIs(err, ErrInvalidAccount)
+	}
+}
+
+func TestVerify(t *testing.T) {
+	require := require.New(t)
+
+	var (
+		sks []crypto.PrivateKey
+		pks []crypto.PublicKey
+	)
+	for i := 0; i < 3; i++ {
+		sk, err := crypto.GenerateKey()
+		require.NoError(err)
+		sks = append(sks, sk)
+		pks = append(pks, sk.PublicKey())
+	}
+	a, err := NewAccount(2, pks)
+	require.NoError(err)
+	require.True(a.Contains(pks[1]))
+
+	h := hash.Hash256b([]byte("treasury transfer"))
+	sigs := make([][]byte, len(sks))
+	for i, sk := range sks {
+		sigs[i], err = sk.Sign(h[:])
+		require.NoError(err)
+	}
+
+	c := a.NewCollector(h[:])
+	require.NoError(c.Add(pks[2], sigs[2]))
+	require.False(c.Complete())
+	require.ErrorIs(c.Add(pks[2], sigs[2]), ErrDuplicateSigner)
+	require.ErrorIs(c.Add(pks[0], sigs[1]), ErrInvalidSignature)
+	require.NoError(c.Add(pks[0], sigs[0]))
+	require.True(c.Complete())
+	require.Equal(2, c.Count())
+	require.NoError(a.Verify(h[:], c.Signatures()))
+	require.NoError(c.Add(pks[1], sigs[1]))
+	require.NoError(a.Verify(h[:], c.Signatures()))
+
+	// threshold not met
+	require.ErrorIs(a.Verify(h[:], c.Signatures()[:1]), ErrThresholdNotMet)
+	// duplicate signer does not count twice
+	require.ErrorIs(a.Verify(h[:], []PartialSig{{pks[0], sigs[0]}, {pks[0], sigs[0]}}), ErrDuplicateSigner)
+	// different hash
+	h2 := hash.Hash256b([]byte("another transfer"))
+	require.ErrorIs(a.Verify(h2[:], c.Signatures()), ErrInvalidSignature)
+
+	// unauthorized signer
+	outsider, err := crypto.GenerateKey()
+	require.NoError(err)
+	require.False(a.Contains(outsider.PublicKey()))
+	sig, err := outsider.Sign(h[:])
+	require.NoError(err)
+	require.ErrorIs(c.Add(outsider.PublicKey(), sig), ErrUnauthorizedSigner)
+	require.ErrorIs(a.Verify(h[:], []PartialSig{{pks[0], sigs[0]}, {outsider.PublicKey(), sig}}), ErrUnauthorizedSigner)
+	require.ErrorIs(a.Verify(h[:], []PartialSig{{nil, sig}}), ErrUnauthorizedSigner)
+}
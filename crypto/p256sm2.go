@@ -10,12 +10,11 @@ import (
 	"crypto/elliptic"
 	"crypto/rand"
 	"encoding/hex"
-	"encoding/pem"
 	"fmt"
 	"math/big"
-	"os"
 
 	"github.com/dustinxie/gmsm/sm2"
+	"github.com/dustinxie/gmsm/sm3"
 	"github.com/ethereum/go-ethereum/common/math"
 	"github.com/iotexproject/iotex-address/address"
 	"github.com/pkg/errors"
@@ -32,73 +31,34 @@ type (
 	P256sm2PubKey struct {
 		*sm2.PublicKey
 	}
-)
-
-// WritePrivateKeyToPem writes the private key to PEM file
-func WritePrivateKeyToPem(file string, key *P256sm2PrvKey, pwd string) error {
-	_, err := sm2.WritePrivateKeytoPem(file, key.PrivateKey, []byte(pwd))
-	return err
-}
 
-// WritePublicKeyToPem writes the public key to PEM file
-func WritePublicKeyToPem(file string, key *P256sm2PubKey, pwd string) error {
-	_, err := sm2.WritePublicKeytoPem(file, key.PublicKey, []byte(pwd))
-	return err
-}
+	// P256sm2SignOption is an option for signing with P256sm2 private key
+	P256sm2SignOption func(*p256sm2SignConfig)
 
-// ReadPrivateKeyFromPem reads the private key from PEM file
-func ReadPrivateKeyFromPem(file string, pwd string) (PrivateKey, error) {
-	sk, err := sm2.ReadPrivateKeyFromPem(file, []byte(pwd))
-	if err != nil {
-		return nil, err
+	p256sm2SignConfig struct {
+		withRecID     bool
+		deterministic bool
 	}
-	return &P256sm2PrvKey{
-		PrivateKey: sk,
-	}, nil
-}
+)
 
-// ReadPublicKeyFromPem reads the public key from PEM file
-func ReadPublicKeyFromPem(file string, pwd string) (PublicKey, error) {
-	pk, err := sm2.ReadPublicKeyFromPem(file, []byte(pwd))
-	if err != nil {
-		return nil, err
+// WithRecoveryID produces the signature in 65-byte (r, s, v) format, so the
+// public key can be recovered from it by RecoverPubkey
+//
+// the hash is signed as-is without the SM2 user identity (ZA) prefix, because
+// ZA is derived from the very public key to be recovered
+func WithRecoveryID() P256sm2SignOption {
+	return func(cfg *p256sm2SignConfig) {
+		cfg.withRecID = true
 	}
-	return &P256sm2PubKey{
-		PublicKey: pk,
-	}, nil
 }
 
-// UpdatePrivateKeyPasswordToPem updates private key's password for PEM file
-func UpdatePrivateKeyPasswordToPem(fileName string, oldPwd string, newPwd string) error {
-	key, err := sm2.ReadPrivateKeyFromPem(fileName, []byte(oldPwd))
-	if err != nil {
-		return err
-	}
-
-	var block *pem.Block
-
-	der, err := sm2.MarshalSm2PrivateKey(key, []byte(newPwd))
-	if err != nil {
-		return err
+// WithDeterministicNonce derives the nonce from the private key and the digest per
+// RFC 6979 with HMAC-SM3 instead of the random source, so the same key and hash
+// always yield the same signature, as secp256k1 signing does
+func WithDeterministicNonce() P256sm2SignOption {
+	return func(cfg *p256sm2SignConfig) {
+		cfg.deterministic = true
 	}
-	if newPwd != "" {
-		block = &pem.Block{
-			Type:  "ENCRYPTED PRIVATE KEY",
-			Bytes: der,
-		}
-	} else {
-		block = &pem.Block{
-			Type:  "PRIVATE KEY",
-			Bytes: der,
-		}
-	}
-	file, err := os.OpenFile(fileName, os.O_WRONLY, 0600)
-	if err != nil {
-		return err
-	}
-	defer file.Close()
-
-	return pem.Encode(file, block)
 }
 
 //======================================
@@ -152,7 +112,47 @@ func (k *P256sm2PrvKey) PublicKey() PublicKey {
 
 // Sign signs the message/hash
 func (k *P256sm2PrvKey) Sign(hash []byte) ([]byte, error) {
-	return k.PrivateKey.Sign(rand.Reader, hash, nil)
+	return k.SignWithOptions(hash)
+}
+
+// SignWithOptions signs the message/hash with the given options
+func (k *P256sm2PrvKey) SignWithOptions(hash []byte, opts ...P256sm2SignOption) ([]byte, error) {
+	cfg := p256sm2SignConfig{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if !cfg.withRecID && !cfg.deterministic {
+		return k.PrivateKey.Sign(rand.Reader, hash, nil)
+	}
+
+	// the recoverable format signs the hash as-is, otherwise e = SM3(ZA || hash)
+	e := hash
+	if !cfg.withRecID {
+		za, err := sm2.ZA(&k.PrivateKey.PublicKey, sm2DefaultUID)
+		if err != nil {
+			return nil, err
+		}
+		e = sm3Sum(za, hash)
+	}
+	nonce := p256sm2RandNonce(k.Params().N)
+	if cfg.deterministic {
+		next := newRFC6979Nonce(sm3.New, k.Params().N, k.PrivateKey.D, e)
+		nonce = func() (*big.Int, error) {
+			return next(), nil
+		}
+	}
+	r, s, v, err := p256sm2SignDigest(k.PrivateKey, new(big.Int).SetBytes(e), nonce)
+	if err != nil {
+		return nil, err
+	}
+	if !cfg.withRecID {
+		return sm2.SignDigitToSignData(r, s)
+	}
+	sig := make([]byte, P256sm2SigSizeWithRecID)
+	r.FillBytes(sig[:32])
+	s.FillBytes(sig[32:64])
+	sig[64] = v + P256sm2RecIDOffset
+	return sig, nil
 }
 
 // Zero zeroes the private key data
@@ -163,6 +163,31 @@ func (k *P256sm2PrvKey) Zero() {
 	}
 }
 
+// KeyType returns the key type
+func (k *P256sm2PrvKey) KeyType() KeyType {
+	return KeyTypeP256sm2
+}
+
+// MarshalText encodes the private key to the hex string of tagged encoding
+func (k *P256sm2PrvKey) MarshalText() ([]byte, error) {
+	return marshalPrivateKeyText(k)
+}
+
+// MarshalJSON encodes the private key to JSON string of MarshalText
+func (k *P256sm2PrvKey) MarshalJSON() ([]byte, error) {
+	return marshalKeyJSON(k.MarshalText())
+}
+
+// UnmarshalText decodes the private key from the hex string of tagged encoding
+func (k *P256sm2PrvKey) UnmarshalText(text []byte) error {
+	key, err := unmarshalPrivateKeyText(text, KeyTypeP256sm2)
+	if err != nil {
+		return err
+	}
+	*k = *key.(*P256sm2PrvKey)
+	return nil
+}
+
 // D returns the secret D in big-endian
 func (k *P256sm2PrvKey) D() []byte {
 	if k.PrivateKey == nil || k.PrivateKey.D == nil {
@@ -208,17 +233,57 @@ func newP256sm2PubKeyFromBytes(b []byte) (PublicKey, error) {
 	if err != nil {
 		return nil, err
 	}
-	return &P256sm2PubKey{
+	k := &P256sm2PubKey{
 		PublicKey: pk,
-	}, nil
+	}
+	// the point is left nil by the parser if it is not on curve
+	if _, err := k.sm2PublicKey(); err != nil {
+		return nil, err
+	}
+	return k, nil
+}
+
+// sm2PublicKey returns the sm2 public key, or error if the point is not on curve
+func (k *P256sm2PubKey) sm2PublicKey() (*sm2.PublicKey, error) {
+	if k.PublicKey == nil || k.X == nil || k.Y == nil || !sm2.P256Sm2().IsOnCurve(k.X, k.Y) {
+		return nil, errors.Wrap(ErrPublicKey, "point is not on curve")
+	}
+	return k.PublicKey, nil
 }
 
 // Bytes returns the public key in bytes representation
 func (k *P256sm2PubKey) Bytes() []byte {
-	b, _ := sm2.MarshalSm2PublicKey(k.PublicKey)
+	pk, err := k.sm2PublicKey()
+	if err != nil {
+		return nil
+	}
+	b, _ := sm2.MarshalSm2PublicKey(pk)
 	return b
 }
 
+// CompressedBytes returns the public key in compressed form, which is the tagged
+// encoding of EncodePublicKey, the 33-byte compressed point prefixed by the key type
+//
+// the untagged point has the same layout as a compressed secp256k1 key, the prefix
+// tells them apart in BytesToPublicKey. It returns nil if the point is not on curve
+func (k *P256sm2PubKey) CompressedBytes() []byte {
+	p := k.compressedPoint()
+	if p == nil {
+		return nil
+	}
+	return tagKey(codecP256sm2Pub, p)
+}
+
+// compressedPoint returns the 33-byte compressed point, or nil if the point is not
+// on curve
+func (k *P256sm2PubKey) compressedPoint() []byte {
+	pk, err := k.sm2PublicKey()
+	if err != nil {
+		return nil
+	}
+	return sm2.Compress(pk)
+}
+
 // HexString returns the public key in hex string
 func (k *P256sm2PubKey) HexString() string {
 	return hex.EncodeToString(k.Bytes())
@@ -240,7 +305,20 @@ func (k *P256sm2PubKey) Hash() []byte {
 
 // Verify verifies the signature
 func (k *P256sm2PubKey) Verify(hash, sig []byte) bool {
-	return k.PublicKey.Verify(hash, sig)
+	if k.PublicKey.Verify(hash, sig) {
+		return true
+	}
+	if !isP256sm2SigWithRecID(sig) {
+		return false
+	}
+	r := new(big.Int).SetBytes(sig[:32])
+	s := new(big.Int).SetBytes(sig[32:64])
+	return sm2.Verify(k.PublicKey, hash, r, s)
+}
+
+// VerifySignature verifies the parsed signature
+func (k *P256sm2PubKey) VerifySignature(hash []byte, sig *Signature) bool {
+	return sig.Verify(k, hash)
 }
 
 // Address returns the address object
@@ -248,3 +326,159 @@ func (k *P256sm2PubKey) Address() address.Address {
 	addr, _ := address.FromBytes(k.Hash())
 	return addr
 }
+
+// KeyType returns the key type
+func (k *P256sm2PubKey) KeyType() KeyType {
+	return KeyTypeP256sm2
+}
+
+// MarshalText encodes the public key to the hex string of tagged encoding
+func (k *P256sm2PubKey) MarshalText() ([]byte, error) {
+	return marshalPublicKeyText(k)
+}
+
+// MarshalJSON encodes the public key to JSON string of MarshalText
+func (k *P256sm2PubKey) MarshalJSON() ([]byte, error) {
+	return marshalKeyJSON(k.MarshalText())
+}
+
+// UnmarshalText decodes the public key from the hex string of tagged encoding
+func (k *P256sm2PubKey) UnmarshalText(text []byte) error {
+	key, err := unmarshalPublicKeyText(text, KeyTypeP256sm2)
+	if err != nil {
+		return err
+	}
+	*k = *key.(*P256sm2PubKey)
+	return nil
+}
+
+//======================================
+// signature with recovery id
+//======================================
+
+// p256sm2RandNonce returns the generator of random nonce in [1, n-1]
+func p256sm2RandNonce(n *big.Int) func() (*big.Int, error) {
+	nm1 := new(big.Int).Sub(n, big.NewInt(1))
+	return func() (*big.Int, error) {
+		k, err := rand.Int(rand.Reader, nm1)
+		if err != nil {
+			return nil, err
+		}
+		return k.Add(k, big.NewInt(1)), nil
+	}
+}
+
+// p256sm2SignDigest signs the digest e with the nonce from generator, and returns
+// the signature (r, s) along with the recovery id v, where bit 0 is the parity of
+// y-coordinate of kG, and bit 1 is set if its x-coordinate is no less than the
+// curve order N
+func p256sm2SignDigest(sk *sm2.PrivateKey, e *big.Int, nonce func() (*big.Int, error)) (r, s *big.Int, v byte, err error) {
+	var (
+		curve = sk.Curve
+		n     = curve.Params().N
+		d1Inv = new(big.Int).ModInverse(new(big.Int).Add(sk.D, big.NewInt(1)), n)
+	)
+	for {
+		k, err := nonce()
+		if err != nil {
+			return nil, nil, 0, err
+		}
+
+		// r = (e + x1) mod N, where (x1, y1) = kG
+		x1, y1 := curve.ScalarBaseMult(k.Bytes())
+		r = new(big.Int).Add(e, x1)
+		r.Mod(r, n)
+		if r.Sign() == 0 || new(big.Int).Add(r, k).Cmp(n) == 0 {
+			continue
+		}
+
+		// s = (1 + d)^-1 * (k - r*d) mod N
+		s = new(big.Int).Mul(r, sk.D)
+		s.Sub(k, s)
+		s.Mul(s, d1Inv)
+		s.Mod(s, n)
+		if s.Sign() == 0 {
+			continue
+		}
+
+		v = byte(y1.Bit(0))
+		if x1.Cmp(n) >= 0 {
+			v |= 2
+		}
+		return r, s, v, nil
+	}
+}
+
+func isP256sm2SigWithRecID(sig []byte) bool {
+	return len(sig) == P256sm2SigSizeWithRecID &&
+		sig[P256sm2SigSizeWithRecID-1] >= P256sm2RecIDOffset &&
+		sig[P256sm2SigSizeWithRecID-1] <= P256sm2RecIDOffset+3
+}
+
+// recoverP256sm2 recovers the public key from signature in (r, s, v) format
+//
+// the signing equation gives kG = sG + (r + s)P, hence P = (r + s)^-1 * (kG - sG),
+// and kG is restored from x1 = r - e and the recovery id
+func recoverP256sm2(hash, sig []byte) (PublicKey, error) {
+	if !isP256sm2SigWithRecID(sig) {
+		return nil, ErrInvalidKey
+	}
+	var (
+		curve  = sm2.P256Sm2()
+		params = curve.Params()
+		n      = params.N
+		r      = new(big.Int).SetBytes(sig[:32])
+		s      = new(big.Int).SetBytes(sig[32:64])
+		v      = sig[64] - P256sm2RecIDOffset
+	)
+	if r.Sign() == 0 || s.Sign() == 0 || r.Cmp(n) >= 0 || s.Cmp(n) >= 0 {
+		return nil, ErrInvalidKey
+	}
+	t := new(big.Int).Add(r, s)
+	t.Mod(t, n)
+	if t.Sign() == 0 {
+		return nil, ErrInvalidKey
+	}
+
+	// restore kG = (x1, y1)
+	x1 := new(big.Int).Sub(r, new(big.Int).SetBytes(hash))
+	x1.Mod(x1, n)
+	if v&2 != 0 {
+		x1.Add(x1, n)
+	}
+	if x1.Cmp(params.P) >= 0 {
+		return nil, ErrInvalidKey
+	}
+	// y^2 = x^3 - 3x + b
+	ySq := new(big.Int).Exp(x1, big.NewInt(3), params.P)
+	ySq.Sub(ySq, new(big.Int).Lsh(x1, 1))
+	ySq.Sub(ySq, x1)
+	ySq.Add(ySq, params.B)
+	ySq.Mod(ySq, params.P)
+	y1 := new(big.Int).ModSqrt(ySq, params.P)
+	if y1 == nil {
+		return nil, ErrInvalidKey
+	}
+	if y1.Bit(0) != uint(v&1) {
+		y1.Sub(params.P, y1)
+	}
+
+	// P = -s * t^-1 * G + t^-1 * kG
+	tInv := new(big.Int).ModInverse(t, n)
+	u1 := new(big.Int).Mul(s, tInv)
+	u1.Neg(u1)
+	u1.Mod(u1, n)
+	x, y := curve.ScalarBaseMult(u1.Bytes())
+	x2, y2 := curve.ScalarMult(x1, y1, tInv.Bytes())
+	x, y = curve.Add(x, y, x2, y2)
+	if !curve.IsOnCurve(x, y) {
+		return nil, ErrInvalidKey
+	}
+	return &P256sm2PubKey{
+		PublicKey: &sm2.PublicKey{
+			Curve: curve,
+			X:     x,
+			Y:     y,
+		},
+	}, nil
+}
@@ -7,10 +7,14 @@
 package crypto
 
 import (
+	"encoding/hex"
 	"os"
 	"testing"
 
+	"github.com/dustinxie/gmsm/sm2"
 	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
 )
 
 func Test256sm2(t *testing.T) {
@@ -34,6 +38,12 @@ func Test256sm2(t *testing.T) {
 	require.NoError(err)
 	require.Equal(pk, pk1)
 	require.Equal(20, len(pk.Hash()))
+	require.Equal(35, len(pk.CompressedBytes()))
+	pk2, err := BytesToPublicKey(pk.CompressedBytes())
+	require.NoError(err)
+	require.Equal(pk, pk2)
+	require.Nil((&P256sm2PubKey{&sm2.PublicKey{Curve: sm2.P256Sm2()}}).CompressedBytes())
+	require.Nil((&P256sm2PubKey{&sm2.PublicKey{Curve: sm2.P256Sm2()}}).Bytes())
 	_, ok = pk.EcdsaPublicKey().(*P256sm2PubKey)
 	require.True(ok)
 
@@ -87,3 +97,81 @@ func Test256sm2(t *testing.T) {
 	require.Nil(pk1)
 	require.Equal(ErrInvalidKey, err)
 }
+
+func TestP256sm2Recover(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := GenerateKeySm2()
+	require.NoError(err)
+	k := sk.(*P256sm2PrvKey)
+	pk := sk.PublicKey()
+
+	for i := 0; i < 10; i++ {
+		h := hash.Hash256b([]byte{byte(i)})
+		sig, err := k.SignWithOptions(h[:], WithRecoveryID())
+		require.NoError(err)
+		require.Equal(P256sm2SigSizeWithRecID, len(sig))
+		require.True(sig[64] >= P256sm2RecIDOffset)
+		require.True(pk.Verify(h[:], sig))
+
+		pk1, err := RecoverPubkey(h[:], sig)
+		require.NoError(err)
+		_, ok := pk1.(*P256sm2PubKey)
+		require.True(ok)
+		require.Equal(pk.Bytes(), pk1.Bytes())
+		require.Equal(pk.Address().String(), pk1.Address().String())
+
+		// tampered hash recovers a different key
+		h[0]++
+		require.False(pk.Verify(h[:], sig))
+		pk1, err = RecoverPubkey(h[:], sig)
+		if err == nil {
+			require.NotEqual(pk.Bytes(), pk1.Bytes())
+		}
+	}
+
+	// invalid recovery id
+	h := hash.Hash256b([]byte("test"))
+	sig, err := k.SignWithOptions(h[:], WithRecoveryID())
+	require.NoError(err)
+	sig[64] = P256sm2RecIDOffset + 4
+	require.False(pk.Verify(h[:], sig))
+	_, err = recoverP256sm2(h[:], sig)
+	require.Equal(ErrInvalidKey, err)
+}
+
+func TestP256sm2Deterministic(t *testing.T) {
+	require := require.New(t)
+
+	d := hash.Hash256b([]byte("deterministic"))
+	sk, err := newP256sm2PrvKeyFromD(d[:])
+	require.NoError(err)
+	k := sk.(*P256sm2PrvKey)
+	pk := sk.PublicKey()
+	msg := []byte("test data to be signed")
+
+	for _, v := range []struct {
+		opts []P256sm2SignOption
+		sig  string
+	}{
+		{
+			[]P256sm2SignOption{WithDeterministicNonce()},
+			"3045022100e7bd7b356c2790605832e0bf43225239d673c7470023bad9be62bbcbc668a06d02206be3e02a3e1e0670f6635f30dcb07405f8bfd8ad2909262310a54e372466d04d",
+		},
+		{
+			[]P256sm2SignOption{WithDeterministicNonce(), WithRecoveryID()},
+			"76aa582855721fdf59dd8414f9a06bec52215b4303b96dac1d4d2c179dedf2ceaf16bad3497dfec651400bff4e52ac05c96b09185beba2a306654d59922b71b081",
+		},
+	} {
+		sig, err := k.SignWithOptions(msg, v.opts...)
+		require.NoError(err)
+		require.Equal(v.sig, hex.EncodeToString(sig))
+		require.True(pk.Verify(msg, sig))
+		sig1, err := k.SignWithOptions(msg, v.opts...)
+		require.NoError(err)
+		require.Equal(sig, sig1)
+		sig1, err = k.SignWithOptions(msg[1:], v.opts...)
+		require.NoError(err)
+		require.NotEqual(sig, sig1)
+	}
+}
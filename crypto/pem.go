@@ -0,0 +1,499 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/x509/pkix"
+	"encoding/asn1"
+	"encoding/pem"
+	"hash"
+	"io"
+	"math/big"
+	"os"
+
+	"github.com/dustinxie/gmsm/sm2"
+	"github.com/ethereum/go-ethereum/common/math"
+	"github.com/pkg/errors"
+	"golang.org/x/crypto/pbkdf2"
+)
+
+// PEM block types
+const (
+	pemTypeECPrivateKey        = "EC PRIVATE KEY"
+	pemTypePrivateKey          = "PRIVATE KEY"
+	pemTypeEncryptedPrivateKey = "ENCRYPTED PRIVATE KEY"
+	pemTypePublicKey           = "PUBLIC KEY"
+)
+
+const (
+	pbkdf2Iterations = 100000
+	pbkdf2SaltSize   = 16
+)
+
+var (
+	oidPublicKeyEC         = asn1.ObjectIdentifier{1, 2, 840, 10045, 2, 1}
+	oidNamedCurveSecp256k1 = asn1.ObjectIdentifier{1, 3, 132, 0, 10}
+	oidNamedCurveP256sm2   = asn1.ObjectIdentifier{1, 2, 156, 10197, 1, 301}
+
+	oidPBES2          = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 13}
+	oidPBKDF2         = asn1.ObjectIdentifier{1, 2, 840, 113549, 1, 5, 12}
+	oidHMACWithSHA1   = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 7}
+	oidHMACWithSHA256 = asn1.ObjectIdentifier{1, 2, 840, 113549, 2, 9}
+	oidAES128CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 2}
+	oidAES256CBC      = asn1.ObjectIdentifier{2, 16, 840, 1, 101, 3, 4, 1, 42}
+)
+
+type (
+	// ecPrivateKey is the SEC1 EC private key, per RFC 5915
+	ecPrivateKey struct {
+		Version       int
+		PrivateKey    []byte
+		NamedCurveOID asn1.ObjectIdentifier `asn1:"optional,explicit,tag:0"`
+		PublicKey     asn1.BitString        `asn1:"optional,explicit,tag:1"`
+	}
+
+	// pkcs8 is the PKCS#8 private key, per RFC 5208
+	pkcs8 struct {
+		Version    int
+		Algo       pkix.AlgorithmIdentifier
+		PrivateKey []byte
+	}
+
+	// encryptedPrivateKeyInfo is the encrypted PKCS#8 private key, per RFC 5958
+	encryptedPrivateKeyInfo struct {
+		Algo          pkix.AlgorithmIdentifier
+		EncryptedData []byte
+	}
+
+	// pbes2Params is the parameters of PBES2, per RFC 8018
+	pbes2Params struct {
+		KeyDerivationFunc pkix.AlgorithmIdentifier
+		EncryptionScheme  pkix.AlgorithmIdentifier
+	}
+
+	// pbkdf2Params is the parameters of PBKDF2, per RFC 8018
+	pbkdf2Params struct {
+		Salt           []byte
+		IterationCount int
+		KeyLength      int                      `asn1:"optional"`
+		PRF            pkix.AlgorithmIdentifier `asn1:"optional"`
+	}
+
+	// subjectPublicKeyInfo is the public key, per RFC 5280
+	subjectPublicKeyInfo struct {
+		Algo      pkix.AlgorithmIdentifier
+		PublicKey asn1.BitString
+	}
+)
+
+// WritePrivateKeyToPem writes the secp256k1 or P256sm2 private key to PEM file in
+// encrypted PKCS#8, or in plain PKCS#8 if the password is empty
+func WritePrivateKeyToPem(file string, key PrivateKey, pwd string) error {
+	der, err := marshalPKCS8(key)
+	if err != nil {
+		return err
+	}
+	if pwd == "" {
+		return writePem(file, &pem.Block{
+			Type:  pemTypePrivateKey,
+			Bytes: der,
+		})
+	}
+	der, err = encryptPKCS8(der, []byte(pwd))
+	if err != nil {
+		return err
+	}
+	return writePem(file, &pem.Block{
+		Type:  pemTypeEncryptedPrivateKey,
+		Bytes: der,
+	})
+}
+
+// WriteECPrivateKeyToPem writes the secp256k1 or P256sm2 private key to PEM file in
+// SEC1 "EC PRIVATE KEY" format, which is not encrypted
+func WriteECPrivateKeyToPem(file string, key PrivateKey) error {
+	ec, err := newECPrivateKey(key)
+	if err != nil {
+		return err
+	}
+	der, err := asn1.Marshal(*ec)
+	if err != nil {
+		return err
+	}
+	return writePem(file, &pem.Block{
+		Type:  pemTypeECPrivateKey,
+		Bytes: der,
+	})
+}
+
+// WritePublicKeyToPem writes the secp256k1 or P256sm2 public key to PEM file in
+// SubjectPublicKeyInfo format, the password is not used
+func WritePublicKeyToPem(file string, key PublicKey, pwd string) error {
+	var (
+		curve asn1.ObjectIdentifier
+		point []byte
+	)
+	switch k := key.(type) {
+	case *secp256k1PubKey:
+		curve, point = oidNamedCurveSecp256k1, k.Bytes()
+	case *P256sm2PubKey:
+		curve, point = oidNamedCurveP256sm2, elliptic.Marshal(k.Curve, k.X, k.Y)
+	default:
+		return errors.Wrapf(ErrInvalidKey, "unsupported public key type %T", key)
+	}
+	algo, err := ecAlgorithmIdentifier(curve)
+	if err != nil {
+		return err
+	}
+	der, err := asn1.Marshal(subjectPublicKeyInfo{
+		Algo:      algo,
+		PublicKey: asn1.BitString{Bytes: point, BitLength: 8 * len(point)},
+	})
+	if err != nil {
+		return err
+	}
+	return writePem(file, &pem.Block{
+		Type:  pemTypePublicKey,
+		Bytes: der,
+	})
+}
+
+// ReadPrivateKeyFromPem reads the private key from PEM file, in SEC1, PKCS#8 or
+// encrypted PKCS#8 format. The password must be empty if the key is not encrypted
+func ReadPrivateKeyFromPem(file string, pwd string) (PrivateKey, error) {
+	block, err := readPem(file)
+	if err != nil {
+		return nil, err
+	}
+	encrypted := block.Type != pemTypeECPrivateKey && isEncryptedPKCS8(block.Bytes)
+	if !encrypted && pwd != "" {
+		return nil, errors.Wrap(ErrPrivateKey, "password is given for unencrypted key")
+	}
+	switch block.Type {
+	case pemTypeECPrivateKey:
+		return parseECPrivateKey(block.Bytes, nil)
+	case pemTypePrivateKey, pemTypeEncryptedPrivateKey:
+		der := block.Bytes
+		if encrypted {
+			if der, err = decryptPKCS8(der, []byte(pwd)); err != nil {
+				return nil, err
+			}
+		}
+		return parsePKCS8(der)
+	default:
+		return nil, errors.Wrapf(ErrPrivateKey, "unsupported PEM type %s", block.Type)
+	}
+}
+
+// ReadPublicKeyFromPem reads the public key from PEM file in SubjectPublicKeyInfo
+// format, the password is not used
+func ReadPublicKeyFromPem(file string, pwd string) (PublicKey, error) {
+	block, err := readPem(file)
+	if err != nil {
+		return nil, err
+	}
+	if block.Type != pemTypePublicKey {
+		return nil, errors.Wrapf(ErrPublicKey, "unsupported PEM type %s", block.Type)
+	}
+	var spki subjectPublicKeyInfo
+	if _, err := asn1.Unmarshal(block.Bytes, &spki); err != nil {
+		return nil, errors.Wrap(ErrPublicKey, err.Error())
+	}
+	curve, err := ecCurveOf(spki.Algo)
+	if err != nil {
+		return nil, errors.Wrap(ErrPublicKey, err.Error())
+	}
+	point := spki.PublicKey.RightAlign()
+	switch {
+	case curve.Equal(oidNamedCurveSecp256k1):
+		return newSecp256k1PubKeyFromBytes(point)
+	case curve.Equal(oidNamedCurveP256sm2):
+		x, y := elliptic.Unmarshal(sm2.P256Sm2(), point)
+		if x == nil {
+			return nil, ErrPublicKey
+		}
+		return &P256sm2PubKey{
+			PublicKey: &sm2.PublicKey{Curve: sm2.P256Sm2(), X: x, Y: y},
+		}, nil
+	default:
+		return nil, errors.Wrapf(ErrPublicKey, "unsupported curve %s", curve)
+	}
+}
+
+// UpdatePrivateKeyPasswordToPem updates private key's password for PEM file, the
+// key is rewritten in PKCS#8 as WritePrivateKeyToPem, which is not encrypted if the
+// new password is empty
+func UpdatePrivateKeyPasswordToPem(fileName string, oldPwd string, newPwd string) error {
+	key, err := ReadPrivateKeyFromPem(fileName, oldPwd)
+	if err != nil {
+		return err
+	}
+	defer key.Zero()
+	return WritePrivateKeyToPem(fileName, key, newPwd)
+}
+
+func writePem(file string, block *pem.Block) error {
+	return os.WriteFile(file, pem.EncodeToMemory(block), 0600)
+}
+
+func readPem(file string) (*pem.Block, error) {
+	data, err := os.ReadFile(file)
+	if err != nil {
+		return nil, err
+	}
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.Wrapf(ErrInvalidKey, "failed to decode PEM file %s", file)
+	}
+	return block, nil
+}
+
+//======================================
+// SEC1 and PKCS#8
+//======================================
+
+func newECPrivateKey(key PrivateKey) (*ecPrivateKey, error) {
+	var (
+		curve asn1.ObjectIdentifier
+		d     []byte
+		point []byte
+	)
+	switch k := key.(type) {
+	case *secp256k1PrvKey:
+		curve, d, point = oidNamedCurveSecp256k1, k.Bytes(), k.PublicKey().Bytes()
+	case *P256sm2PrvKey:
+		curve, d, point = oidNamedCurveP256sm2, k.D(), elliptic.Marshal(k.Curve, k.X, k.Y)
+	default:
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported private key type %T", key)
+	}
+	return &ecPrivateKey{
+		Version:       1,
+		PrivateKey:    d,
+		NamedCurveOID: curve,
+		PublicKey:     asn1.BitString{Bytes: point, BitLength: 8 * len(point)},
+	}, nil
+}
+
+// parseECPrivateKey parses the SEC1 private key, the curve is taken from PKCS#8
+// algorithm identifier if not present in the key
+func parseECPrivateKey(der []byte, curve asn1.ObjectIdentifier) (PrivateKey, error) {
+	var ec ecPrivateKey
+	if _, err := asn1.Unmarshal(der, &ec); err != nil {
+		return nil, errors.Wrap(ErrPrivateKey, err.Error())
+	}
+	if ec.Version != 1 {
+		return nil, errors.Wrapf(ErrPrivateKey, "unsupported EC private key version %d", ec.Version)
+	}
+	if len(ec.NamedCurveOID) > 0 {
+		if len(curve) > 0 && !curve.Equal(ec.NamedCurveOID) {
+			return nil, errors.Wrap(ErrPrivateKey, "curve mismatch")
+		}
+		curve = ec.NamedCurveOID
+	}
+	k := new(big.Int).SetBytes(ec.PrivateKey)
+	if k.BitLen() > 256 {
+		return nil, ErrPrivateKey
+	}
+	d := math.PaddedBigBytes(k, 32)
+	switch {
+	case curve.Equal(oidNamedCurveSecp256k1):
+		sk, err := newSecp256k1PrvKeyFromBytes(d)
+		if err != nil {
+			return nil, errors.Wrap(ErrPrivateKey, err.Error())
+		}
+		return sk, nil
+	case curve.Equal(oidNamedCurveP256sm2):
+		sk, err := newP256sm2PrvKeyFromD(d)
+		if err != nil {
+			return nil, errors.Wrap(ErrPrivateKey, err.Error())
+		}
+		return sk, nil
+	default:
+		return nil, errors.Wrapf(ErrPrivateKey, "unsupported curve %s", curve)
+	}
+}
+
+func marshalPKCS8(key PrivateKey) ([]byte, error) {
+	ec, err := newECPrivateKey(key)
+	if err != nil {
+		return nil, err
+	}
+	algo, err := ecAlgorithmIdentifier(ec.NamedCurveOID)
+	if err != nil {
+		return nil, err
+	}
+	// the curve is in algorithm identifier already, per RFC 5915
+	ec.NamedCurveOID = nil
+	b, err := asn1.Marshal(*ec)
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(pkcs8{
+		Algo:       algo,
+		PrivateKey: b,
+	})
+}
+
+func parsePKCS8(der []byte) (PrivateKey, error) {
+	var p pkcs8
+	if _, err := asn1.Unmarshal(der, &p); err != nil {
+		return nil, errors.Wrap(ErrPrivateKey, err.Error())
+	}
+	curve, err := ecCurveOf(p.Algo)
+	if err != nil {
+		return nil, errors.Wrap(ErrPrivateKey, err.Error())
+	}
+	return parseECPrivateKey(p.PrivateKey, curve)
+}
+
+func ecAlgorithmIdentifier(curve asn1.ObjectIdentifier) (pkix.AlgorithmIdentifier, error) {
+	params, err := asn1.Marshal(curve)
+	if err != nil {
+		return pkix.AlgorithmIdentifier{}, err
+	}
+	return pkix.AlgorithmIdentifier{
+		Algorithm:  oidPublicKeyEC,
+		Parameters: asn1.RawValue{FullBytes: params},
+	}, nil
+}
+
+func ecCurveOf(algo pkix.AlgorithmIdentifier) (asn1.ObjectIdentifier, error) {
+	if !algo.Algorithm.Equal(oidPublicKeyEC) {
+		return nil, errors.Errorf("unsupported key algorithm %s", algo.Algorithm)
+	}
+	var curve asn1.ObjectIdentifier
+	if _, err := asn1.Unmarshal(algo.Parameters.FullBytes, &curve); err != nil {
+		return nil, errors.Wrap(err, "failed to parse named curve")
+	}
+	return curve, nil
+}
+
+//======================================
+// PBES2 encryption of PKCS#8
+//======================================
+
+// encryptPKCS8 encrypts the PKCS#8 private key with PBES2, using PBKDF2-HMAC-SHA256
+// and AES-256-CBC
+func encryptPKCS8(der, pwd []byte) ([]byte, error) {
+	salt := make([]byte, pbkdf2SaltSize)
+	iv := make([]byte, aes.BlockSize)
+	if _, err := io.ReadFull(rand.Reader, salt); err != nil {
+		return nil, err
+	}
+	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(pbkdf2.Key(pwd, salt, pbkdf2Iterations, 32, sha256.New))
+	if err != nil {
+		return nil, err
+	}
+	// PKCS#7 padding
+	padding := aes.BlockSize - len(der)%aes.BlockSize
+	data := append(append([]byte{}, der...), bytes.Repeat([]byte{byte(padding)}, padding)...)
+	cipher.NewCBCEncrypter(block, iv).CryptBlocks(data, data)
+
+	kdfParams, err := asn1.Marshal(pbkdf2Params{
+		Salt:           salt,
+		IterationCount: pbkdf2Iterations,
+		PRF:            pkix.AlgorithmIdentifier{Algorithm: oidHMACWithSHA256, Parameters: asn1.NullRawValue},
+	})
+	if err != nil {
+		return nil, err
+	}
+	ivParams, err := asn1.Marshal(iv)
+	if err != nil {
+		return nil, err
+	}
+	params, err := asn1.Marshal(pbes2Params{
+		KeyDerivationFunc: pkix.AlgorithmIdentifier{Algorithm: oidPBKDF2, Parameters: asn1.RawValue{FullBytes: kdfParams}},
+		EncryptionScheme:  pkix.AlgorithmIdentifier{Algorithm: oidAES256CBC, Parameters: asn1.RawValue{FullBytes: ivParams}},
+	})
+	if err != nil {
+		return nil, err
+	}
+	return asn1.Marshal(encryptedPrivateKeyInfo{
+		Algo:          pkix.AlgorithmIdentifier{Algorithm: oidPBES2, Parameters: asn1.RawValue{FullBytes: params}},
+		EncryptedData: data,
+	})
+}
+
+func isEncryptedPKCS8(der []byte) bool {
+	var info encryptedPrivateKeyInfo
+	_, err := asn1.Unmarshal(der, &info)
+	return err == nil && info.Algo.Algorithm.Equal(oidPBES2)
+}
+
+// decryptPKCS8 decrypts the PBES2-encrypted PKCS#8 private key, which supports
+// PBKDF2 with HMAC-SHA1 or HMAC-SHA256, and AES-128-CBC or AES-256-CBC
+func decryptPKCS8(der, pwd []byte) ([]byte, error) {
+	var (
+		info   encryptedPrivateKeyInfo
+		params pbes2Params
+		kdf    pbkdf2Params
+		iv     []byte
+	)
+	if _, err := asn1.Unmarshal(der, &info); err != nil {
+		return nil, errors.Wrap(ErrPrivateKey, err.Error())
+	}
+	if !info.Algo.Algorithm.Equal(oidPBES2) {
+		return nil, errors.Wrapf(ErrPrivateKey, "unsupported encryption %s", info.Algo.Algorithm)
+	}
+	if _, err := asn1.Unmarshal(info.Algo.Parameters.FullBytes, &params); err != nil {
+		return nil, errors.Wrap(ErrPrivateKey, err.Error())
+	}
+	if !params.KeyDerivationFunc.Algorithm.Equal(oidPBKDF2) {
+		return nil, errors.Wrapf(ErrPrivateKey, "unsupported key derivation %s", params.KeyDerivationFunc.Algorithm)
+	}
+	if _, err := asn1.Unmarshal(params.KeyDerivationFunc.Parameters.FullBytes, &kdf); err != nil {
+		return nil, errors.Wrap(ErrPrivateKey, err.Error())
+	}
+	var newHash func() hash.Hash
+	switch prf := kdf.PRF.Algorithm; {
+	case len(prf) == 0 || prf.Equal(oidHMACWithSHA1):
+		newHash = sha1.New
+	case prf.Equal(oidHMACWithSHA256):
+		newHash = sha256.New
+	default:
+		return nil, errors.Wrapf(ErrPrivateKey, "unsupported PRF %s", prf)
+	}
+	var keyLen int
+	switch enc := params.EncryptionScheme.Algorithm; {
+	case enc.Equal(oidAES128CBC):
+		keyLen = 16
+	case enc.Equal(oidAES256CBC):
+		keyLen = 32
+	default:
+		return nil, errors.Wrapf(ErrPrivateKey, "unsupported encryption scheme %s", enc)
+	}
+	if _, err := asn1.Unmarshal(params.EncryptionScheme.Parameters.FullBytes, &iv); err != nil {
+		return nil, errors.Wrap(ErrPrivateKey, err.Error())
+	}
+	data := info.EncryptedData
+	if len(iv) != aes.BlockSize || len(data) == 0 || len(data)%aes.BlockSize != 0 {
+		return nil, errors.Wrap(ErrPrivateKey, "invalid encrypted data")
+	}
+
+	block, err := aes.NewCipher(pbkdf2.Key(pwd, kdf.Salt, kdf.IterationCount, keyLen, newHash))
+	if err != nil {
+		return nil, err
+	}
+	data = append([]byte{}, data...)
+	cipher.NewCBCDecrypter(block, iv).CryptBlocks(data, data)
+	// a wrong password most likely results in invalid padding
+	padding := int(data[len(data)-1])
+	if padding == 0 || padding > aes.BlockSize || !bytes.Equal(data[len(data)-padding:], bytes.Repeat([]byte{byte(padding)}, padding)) {
+		return nil, errors.Wrap(ErrPrivateKey, "incorrect password")
+	}
+	return data[:len(data)-padding], nil
+}
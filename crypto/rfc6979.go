@@ -0,0 +1,87 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/hmac"
+	"hash"
+	"math/big"
+)
+
+// newRFC6979Nonce returns the deterministic nonce generator per RFC 6979 section 3.2,
+// for private key x and message hash h1 on the curve of order q
+//
+// each call of the returned function yields the next candidate in [1, q-1], so the
+// caller can keep calling it if the nonce turns out unusable (e.g. r = 0)
+func newRFC6979Nonce(newHash func() hash.Hash, q, x *big.Int, h1 []byte) func() *big.Int {
+	var (
+		qlen = q.BitLen()
+		rlen = (qlen + 7) >> 3
+		hlen = newHash().Size()
+		v    = make([]byte, hlen)
+		k    = make([]byte, hlen)
+	)
+	mac := func(key []byte, data ...[]byte) []byte {
+		m := hmac.New(newHash, key)
+		for _, d := range data {
+			m.Write(d)
+		}
+		return m.Sum(nil)
+	}
+
+	// step b, c
+	for i := range v {
+		v[i] = 0x01
+	}
+	// step d - g
+	bx := [][]byte{rfc6979IntToOctets(x, rlen), rfc6979BitsToOctets(h1, q, rlen)}
+	k = mac(k, append([][]byte{v, {0x00}}, bx...)...)
+	v = mac(k, v)
+	k = mac(k, append([][]byte{v, {0x01}}, bx...)...)
+	v = mac(k, v)
+
+	first := true
+	return func() *big.Int {
+		for {
+			if !first {
+				k = mac(k, v, []byte{0x00})
+				v = mac(k, v)
+			}
+			first = false
+			// step h
+			var t []byte
+			for len(t) < rlen {
+				v = mac(k, v)
+				t = append(t, v...)
+			}
+			nonce := rfc6979BitsToInt(t, qlen)
+			if nonce.Sign() > 0 && nonce.Cmp(q) < 0 {
+				return nonce
+			}
+		}
+	}
+}
+
+func rfc6979BitsToInt(b []byte, qlen int) *big.Int {
+	v := new(big.Int).SetBytes(b)
+	if blen := len(b) * 8; blen > qlen {
+		v.Rsh(v, uint(blen-qlen))
+	}
+	return v
+}
+
+func rfc6979IntToOctets(v *big.Int, rlen int) []byte {
+	return v.FillBytes(make([]byte, rlen))
+}
+
+func rfc6979BitsToOctets(b []byte, q *big.Int, rlen int) []byte {
+	z := rfc6979BitsToInt(b, q.BitLen())
+	if z.Cmp(q) >= 0 {
+		z.Sub(z, q)
+	}
+	return rfc6979IntToOctets(z, rlen)
+}
@@ -0,0 +1,174 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+
+	"github.com/btcsuite/btcd/btcec/v2"
+	"github.com/btcsuite/btcd/btcec/v2/schnorr"
+	"github.com/pkg/errors"
+)
+
+// const
+const (
+	// SchnorrSigSize is the size of BIP-340 signature (R.x || s)
+	SchnorrSigSize = schnorr.SignatureSize
+	// XOnlyPubKeySize is the size of BIP-340 x-only public key
+	XOnlyPubKeySize = schnorr.PubKeyBytesLen
+)
+
+var (
+	schnorrChallengeTag = sha256.Sum256([]byte("BIP0340/challenge"))
+)
+
+// SchnorrSign signs the 32-byte hash per BIP-340 with fresh auxiliary randomness,
+// only secp256k1 private key is supported
+func SchnorrSign(sk PrivateKey, hash []byte) ([]byte, error) {
+	var aux [32]byte
+	if _, err := io.ReadFull(rand.Reader, aux[:]); err != nil {
+		return nil, errors.Wrap(err, "failed to generate auxiliary randomness")
+	}
+	return schnorrSign(sk, hash, aux)
+}
+
+func schnorrSign(sk PrivateKey, hash []byte, aux [32]byte) ([]byte, error) {
+	k, ok := sk.(*secp256k1PrvKey)
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported private key type %T", sk)
+	}
+	// schnorr.Sign negates the scalar in place for odd y, so always work on a copy
+	priv, _ := btcec.PrivKeyFromBytes(k.Bytes())
+	defer priv.Zero()
+	sig, err := schnorr.Sign(priv, hash, schnorr.CustomNonce(aux))
+	if err != nil {
+		return nil, err
+	}
+	return sig.Serialize(), nil
+}
+
+// SchnorrVerify verifies the BIP-340 signature of the 32-byte hash, the public key
+// is used by its x coordinate only
+func SchnorrVerify(pk PublicKey, hash, sig []byte) bool {
+	p, err := toBtcecPubKey(pk)
+	if err != nil {
+		return false
+	}
+	s, err := schnorr.ParseSignature(sig)
+	if err != nil {
+		return false
+	}
+	return s.Verify(hash, p)
+}
+
+// SchnorrBatchVerify verifies the BIP-340 signatures all at once, which is faster
+// than verifying them one by one, it returns true only if all signatures are valid
+func SchnorrBatchVerify(items []VerifyItem) bool {
+	var (
+		sumS   btcec.ModNScalar
+		sumPts btcec.JacobianPoint
+	)
+	for i, e := range items {
+		p, err := toBtcecPubKey(e.PubKey)
+		if err != nil || len(e.Hash) != 32 || len(e.Sig) != SchnorrSigSize {
+			return false
+		}
+		// R = lift_x(r), fails if r >= p or not on curve
+		r, err := schnorr.ParsePubKey(e.Sig[:32])
+		if err != nil {
+			return false
+		}
+		var s btcec.ModNScalar
+		if overflow := s.SetByteSlice(e.Sig[32:]); overflow {
+			return false
+		}
+		px := schnorr.SerializePubKey(p)
+		p, _ = schnorr.ParsePubKey(px)
+		var ch btcec.ModNScalar
+		ch.SetByteSlice(schnorrTaggedHash(e.Sig[:32], px, e.Hash))
+
+		// random weight a_i in [1, n-1], a_0 = 1
+		var a btcec.ModNScalar
+		if i == 0 {
+			a.SetInt(1)
+		} else {
+			var b [32]byte
+			for a.IsZero() {
+				if _, err := io.ReadFull(rand.Reader, b[:]); err != nil {
+					return false
+				}
+				a.SetBytes(&b)
+			}
+		}
+
+		// sum(a_i * s_i) and sum(a_i * R_i + a_i * e_i * P_i)
+		s.Mul(&a)
+		sumS.Add(&s)
+		var rj, pj, t btcec.JacobianPoint
+		r.AsJacobian(&rj)
+		p.AsJacobian(&pj)
+		btcec.ScalarMultNonConst(&a, &rj, &t)
+		btcec.AddNonConst(&sumPts, &t, &sumPts)
+		ch.Mul(&a)
+		btcec.ScalarMultNonConst(&ch, &pj, &t)
+		btcec.AddNonConst(&sumPts, &t, &sumPts)
+	}
+	if len(items) == 0 {
+		return true
+	}
+
+	// check sum(a_i * s_i) * G - sum(a_i * R_i + a_i * e_i * P_i) is infinity
+	var sG, res btcec.JacobianPoint
+	btcec.ScalarBaseMultNonConst(&sumS, &sG)
+	sumPts.ToAffine()
+	sumPts.Y.Negate(1).Normalize()
+	btcec.AddNonConst(&sG, &sumPts, &res)
+	return (res.X.IsZero() && res.Y.IsZero()) || res.Z.IsZero()
+}
+
+// XOnlyPubKeyBytes returns the 32-byte x-only public key per BIP-340
+func XOnlyPubKeyBytes(pk PublicKey) ([]byte, error) {
+	p, err := toBtcecPubKey(pk)
+	if err != nil {
+		return nil, err
+	}
+	return schnorr.SerializePubKey(p), nil
+}
+
+// BytesToXOnlyPublicKey converts the 32-byte x-only public key to the secp256k1
+// PublicKey with even y coordinate
+//
+// BytesToPublicKey treats 32-byte input as Ed25519 key, so it has to be called
+// explicitly
+func BytesToXOnlyPublicKey(b []byte) (PublicKey, error) {
+	p, err := schnorr.ParsePubKey(b)
+	if err != nil {
+		return nil, errors.Wrap(ErrPublicKey, err.Error())
+	}
+	return newSecp256k1PubKeyFromBytes(p.SerializeUncompressed())
+}
+
+func toBtcecPubKey(pk PublicKey) (*btcec.PublicKey, error) {
+	k, ok := pk.(*secp256k1PubKey)
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported public key type %T", pk)
+	}
+	return btcec.ParsePubKey(k.Bytes())
+}
+
+// schnorrTaggedHash returns the BIP-340 challenge hash of (r || P || m)
+func schnorrTaggedHash(data ...[]byte) []byte {
+	h := sha256.New()
+	h.Write(schnorrChallengeTag[:])
+	h.Write(schnorrChallengeTag[:])
+	for _, d := range data {
+		h.Write(d)
+	}
+	return h.Sum(nil)
+}
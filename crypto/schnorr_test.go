@@ -0,0 +1,142 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"encoding/hex"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+func TestSchnorrVector(t *testing.T) {
+	require := require.New(t)
+
+	// test vectors in BIP-340
+	tests := []struct {
+		sk, pk, aux, msg, sig string
+		valid                 bool
+	}{
+		{
+			"0000000000000000000000000000000000000000000000000000000000000003",
+			"F9308A019258C31049344F85F89D5229B531C845836F99B08601F113BCE036F9",
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"0000000000000000000000000000000000000000000000000000000000000000",
+			"E907831F80848D1069A5371B402410364BDF1C5F8307B0084C55F1CE2DCA821525F66A4A85EA8B71E482A74F382D2CE5EBEEE8FDB2172F477DF4900D310536C0",
+			true,
+		},
+		{
+			"B7E151628AED2A6ABF7158809CF4F3C762E7160F38B4DA56A784D9045190CFEF",
+			"DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			"0000000000000000000000000000000000000000000000000000000000000001",
+			"243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			"6896BD60EEAE296DB48A229FF71DFE071BDE413E6D43F917DC8DCF8C78DE33418906D11AC976ABCCB20B091292BFF4EA897EFCB639EA871CFA95F6DE339E4B0A",
+			true,
+		},
+		{
+			"",
+			"D69C3509BB99E412E68B0FE8544E72837DFA30746D8BE2AA65975F29D22DC7B9",
+			"",
+			"4DF3C3F68FCC83B27E9D42C90431A72499F17875C81A599B566C9889B9696703",
+			"00000000000000000000003B78CE563F89A0ED9414F5AA28AD0D96D6795F9C6376AFB1548AF603B3EB45C9F8207DEE1060CB71C04E80F593060B07D28308D7F4",
+			true,
+		},
+		{
+			// negated message
+			"",
+			"DFF1D77F2A671C5F36183726DB2341BE58FEAE1DA2DECED843240F7B502BA659",
+			"",
+			"243F6A8885A308D313198A2E03707344A4093822299F31D0082EFA98EC4E6C89",
+			"1FA62E331EDBC21C394792D2AB1100A7B432B013DF3F6FF4F99FCB33E0E1515F28890B3EDB6E7189B630448B515CE4F8622A954CFE545735AAEA5134FCCDB2BD",
+			false,
+		},
+	}
+
+	for _, v := range tests {
+		pkb, _ := hex.DecodeString(v.pk)
+		pk, err := BytesToXOnlyPublicKey(pkb)
+		require.NoError(err)
+		x, err := XOnlyPubKeyBytes(pk)
+		require.NoError(err)
+		require.Equal(pkb, x)
+		msg, _ := hex.DecodeString(v.msg)
+		sig, _ := hex.DecodeString(v.sig)
+		require.Equal(v.valid, SchnorrVerify(pk, msg, sig))
+		require.Equal(v.valid, SchnorrBatchVerify([]VerifyItem{{pk, msg, sig}}))
+		if v.sk == "" {
+			continue
+		}
+		sk, err := HexStringToPrivateKey(v.sk)
+		require.NoError(err)
+		var aux [32]byte
+		b, _ := hex.DecodeString(v.aux)
+		copy(aux[:], b)
+		sig1, err := schnorrSign(sk, msg, aux)
+		require.NoError(err)
+		require.Equal(v.sig, strings.ToUpper(hex.EncodeToString(sig1)))
+		// private key is intact
+		require.Equal(strings.ToLower(v.sk), sk.HexString())
+	}
+
+	// public key not on curve
+	pkb, _ := hex.DecodeString("EEFDEA4CDB677750A420FEE807EACF21EB9898AE79B9768766E4FAA04A2D4A34")
+	_, err := BytesToXOnlyPublicKey(pkb)
+	require.ErrorIs(err, ErrPublicKey)
+}
+
+func TestSchnorr(t *testing.T) {
+	require := require.New(t)
+
+	var items []VerifyItem
+	for i := 0; i < 8; i++ {
+		sk, err := GenerateKey()
+		require.NoError(err)
+		h := hash.Hash256b([]byte{byte(i)})
+		sig, err := SchnorrSign(sk, h[:])
+		require.NoError(err)
+		require.Equal(SchnorrSigSize, len(sig))
+		pk := sk.PublicKey()
+		require.True(SchnorrVerify(pk, h[:], sig))
+		// ECDSA signature is not accepted
+		ecdsaSig, err := sk.Sign(h[:])
+		require.NoError(err)
+		require.False(SchnorrVerify(pk, h[:], ecdsaSig[:Secp256k1SigSize]))
+		require.False(pk.Verify(h[:], sig))
+
+		// x-only key verifies the same signature
+		x, err := XOnlyPubKeyBytes(pk)
+		require.NoError(err)
+		require.Equal(XOnlyPubKeySize, len(x))
+		require.Equal(pk.CompressedBytes()[1:], x)
+		xpk, err := BytesToXOnlyPublicKey(x)
+		require.NoError(err)
+		require.True(SchnorrVerify(xpk, h[:], sig))
+		items = append(items, VerifyItem{pk, h[:], sig})
+	}
+	require.True(SchnorrBatchVerify(items))
+	require.True(SchnorrBatchVerify(nil))
+
+	// any invalid item fails the batch
+	items[3].Hash = items[4].Hash
+	require.False(SchnorrBatchVerify(items))
+	items[3].Hash = items[2].Hash
+	items[3].PubKey = items[2].PubKey
+	require.False(SchnorrBatchVerify(items))
+
+	// unsupported key type
+	sk, err := GenerateKeySm2()
+	require.NoError(err)
+	_, err = SchnorrSign(sk, items[0].Hash)
+	require.ErrorIs(err, ErrInvalidKey)
+	_, err = XOnlyPubKeyBytes(sk.PublicKey())
+	require.ErrorIs(err, ErrInvalidKey)
+	require.False(SchnorrVerify(sk.PublicKey(), items[0].Hash, items[0].Sig))
+	require.False(SchnorrBatchVerify([]VerifyItem{{sk.PublicKey(), items[0].Hash, items[0].Sig}}))
+}
@@ -9,6 +9,7 @@ package crypto
 import (
 	"crypto/ecdsa"
 	"encoding/hex"
+	"math/big"
 
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/iotexproject/iotex-address/address"
@@ -89,6 +90,31 @@ func (k *secp256k1PrvKey) Zero() {
 	}
 }
 
+// KeyType returns the key type
+func (k *secp256k1PrvKey) KeyType() KeyType {
+	return KeyTypeSecp256k1
+}
+
+// MarshalText encodes the private key to the hex string of tagged encoding
+func (k *secp256k1PrvKey) MarshalText() ([]byte, error) {
+	return marshalPrivateKeyText(k)
+}
+
+// MarshalJSON encodes the private key to JSON string of MarshalText
+func (k *secp256k1PrvKey) MarshalJSON() ([]byte, error) {
+	return marshalKeyJSON(k.MarshalText())
+}
+
+// UnmarshalText decodes the private key from the hex string of tagged encoding
+func (k *secp256k1PrvKey) UnmarshalText(text []byte) error {
+	key, err := unmarshalPrivateKeyText(text, KeyTypeSecp256k1)
+	if err != nil {
+		return err
+	}
+	*k = *key.(*secp256k1PrvKey)
+	return nil
+}
+
 //======================================
 // PublicKey function
 //======================================
@@ -99,6 +125,13 @@ var (
 
 // newSecp256k1PubKeyFromBytes converts bytes format to PublicKey
 func newSecp256k1PubKeyFromBytes(b []byte) (PublicKey, error) {
+	if isCompressedP256k1PubkeyBytes(b) {
+		pk, err := crypto.DecompressPubkey(b)
+		if err != nil {
+			return nil, ErrPublicKey
+		}
+		b = crypto.FromECDSAPub(pk)
+	}
 	if !validateP256k1PubkeyBytes(b) {
 		return nil, ErrPublicKey
 	}
@@ -115,8 +148,14 @@ func validateP256k1PubkeyBytes(data []byte) bool {
 	if data[0] != 4 { // uncompressed form
 		return false
 	}
+	// the point must be on curve, a key from remote party may be invalid
+	x := new(big.Int).SetBytes(data[1 : 1+secp256k1PubKeyByteLen])
+	y := new(big.Int).SetBytes(data[1+secp256k1PubKeyByteLen:])
+	return crypto.S256().IsOnCurve(x, y)
+}
 
-	return true
+func isCompressedP256k1PubkeyBytes(data []byte) bool {
+	return len(data) == 1+secp256k1PubKeyByteLen && (data[0] == 2 || data[0] == 3)
 }
 
 // Bytes returns the public key in bytes representation
@@ -124,6 +163,14 @@ func (k *secp256k1PubKey) Bytes() []byte {
 	return k.raw
 }
 
+// CompressedBytes returns the public key in 33-byte compressed form
+func (k *secp256k1PubKey) CompressedBytes() []byte {
+	b := make([]byte, 1+secp256k1PubKeyByteLen)
+	b[0] = 2 + k.raw[len(k.raw)-1]&1
+	copy(b[1:], k.raw[1:1+secp256k1PubKeyByteLen])
+	return b
+}
+
 // HexString returns the public key in hex string
 func (k *secp256k1PubKey) HexString() string {
 	return hex.EncodeToString(k.Bytes())
@@ -131,7 +178,7 @@ func (k *secp256k1PubKey) HexString() string {
 
 // EcdsaPublicKey returns the embedded ecdsa publick key
 func (k *secp256k1PubKey) EcdsaPublicKey() interface{} {
-	pk, err := crypto.UnmarshalPubkey(k.raw)
+	pk, err := k.ecdsaPublicKey()
 	if err != nil {
 		// it should be validated when initialized
 		panic(err)
@@ -139,6 +186,15 @@ func (k *secp256k1PubKey) EcdsaPublicKey() interface{} {
 	return pk
 }
 
+// ecdsaPublicKey returns the ecdsa public key, or error if the point is not on curve
+func (k *secp256k1PubKey) ecdsaPublicKey() (*ecdsa.PublicKey, error) {
+	pk, err := crypto.UnmarshalPubkey(k.raw)
+	if err != nil {
+		return nil, errors.Wrap(ErrPublicKey, err.Error())
+	}
+	return pk, nil
+}
+
 // Hash is the last 20-byte of keccak hash of public key (X, Y) co-ordinate, same as Ethereum address generation
 func (k *secp256k1PubKey) Hash() []byte {
 	h := hash.Hash160b(k.Bytes()[1:])
@@ -161,8 +217,38 @@ func (k *secp256k1PubKey) Verify(hash, sig []byte) bool {
 	return crypto.VerifySignature(k.Bytes(), hash, sig[:Secp256k1SigSize])
 }
 
+// VerifySignature verifies the parsed signature, 64-byte R || S is also accepted
+func (k *secp256k1PubKey) VerifySignature(hash []byte, sig *Signature) bool {
+	return sig.Verify(k, hash)
+}
+
 // Address returns the address object
 func (k *secp256k1PubKey) Address() address.Address {
 	addr, _ := address.FromBytes(k.Hash())
 	return addr
 }
+
+// KeyType returns the key type
+func (k *secp256k1PubKey) KeyType() KeyType {
+	return KeyTypeSecp256k1
+}
+
+// MarshalText encodes the public key to the hex string of tagged encoding
+func (k *secp256k1PubKey) MarshalText() ([]byte, error) {
+	return marshalPublicKeyText(k)
+}
+
+// MarshalJSON encodes the public key to JSON string of MarshalText
+func (k *secp256k1PubKey) MarshalJSON() ([]byte, error) {
+	return marshalKeyJSON(k.MarshalText())
+}
+
+// UnmarshalText decodes the public key from the hex string of tagged encoding
+func (k *secp256k1PubKey) UnmarshalText(text []byte) error {
+	key, err := unmarshalPublicKeyText(text, KeyTypeSecp256k1)
+	if err != nil {
+		return err
+	}
+	*k = *key.(*secp256k1PubKey)
+	return nil
+}
@@ -36,6 +36,21 @@ func TestSecp256k1(t *testing.T) {
 	_, ok = pk.EcdsaPublicKey().(*ecdsa.PublicKey)
 	require.True(ok)
 
+	// test compressed key
+	cpk := pk.CompressedBytes()
+	require.Equal(secp256pubKeyCompressedLength, len(cpk))
+	require.True(cpk[0] == 2 || cpk[0] == 3)
+	npk, err = newSecp256k1PubKeyFromBytes(cpk)
+	require.NoError(err)
+	require.Equal(pk, npk)
+	cpk[0] ^= 1
+	npk, err = newSecp256k1PubKeyFromBytes(cpk)
+	require.NoError(err)
+	require.NotEqual(pk.Bytes(), npk.Bytes())
+	cpk[0] = 4
+	_, err = newSecp256k1PubKeyFromBytes(cpk)
+	require.Equal(ErrPublicKey, err)
+
 	h := hash.Hash256b([]byte("test secp256k1 signature så∫jaç∂fla´´3jl©˙kl3∆˚83jl≈¥fjs2"))
 	sig, err := sk.Sign(h[:])
 	require.NoError(err)
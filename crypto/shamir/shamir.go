@@ -0,0 +1,236 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+// Package shamir splits a private key into N shares with threshold M per Shamir's
+// secret sharing over GF(2^8), any M of the shares reconstruct the key, while
+// fewer than M shares reveal nothing about it
+package shamir
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"io"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+)
+
+const (
+	// MaxShares is the maximum number of shares
+	MaxShares = 255
+
+	// share is version(1) || key type(1) || threshold(1) || index(1) || fingerprint(4) || value || checksum(4)
+	shareVersion    = 0x01
+	shareHeaderSize = 8
+	fingerprintSize = 4
+	checksumSize    = 4
+)
+
+var (
+	// ErrInvalidThreshold indicates the threshold or number of shares is invalid
+	ErrInvalidThreshold = errors.New("invalid threshold")
+	// ErrInvalidShare indicates the share is corrupt
+	ErrInvalidShare = errors.New("invalid share")
+	// ErrShareMismatch indicates the shares do not belong to the same key
+	ErrShareMismatch = errors.New("shares do not belong to the same key")
+	// ErrDuplicateShare indicates more than one share of the same index
+	ErrDuplicateShare = errors.New("duplicate share")
+	// ErrTooFewShares indicates the number of shares is less than threshold
+	ErrTooFewShares = errors.New("too few shares")
+)
+
+// Share is a decoded share of private key
+type Share struct {
+	KeyType     crypto.KeyType
+	Threshold   int
+	Index       int
+	fingerprint []byte
+	value       []byte
+}
+
+// Split splits the private key into n shares, any threshold of which reconstruct
+// the key, 2 <= threshold <= n <= MaxShares
+func Split(sk crypto.PrivateKey, threshold, n int) ([][]byte, error) {
+	if threshold < 2 || n < threshold || n > MaxShares {
+		return nil, errors.Wrapf(ErrInvalidThreshold, "threshold %d of %d shares", threshold, n)
+	}
+	secret, err := crypto.EncodePrivateKey(sk)
+	if err != nil {
+		return nil, err
+	}
+	defer zero(secret)
+
+	// a random polynomial of degree threshold-1 for each byte of secret, whose
+	// constant term is the byte
+	coeffs := make([]byte, len(secret)*(threshold-1))
+	if _, err := io.ReadFull(rand.Reader, coeffs); err != nil {
+		return nil, errors.Wrap(err, "failed to generate coefficients")
+	}
+	defer zero(coeffs)
+
+	fp := fingerprint(sk.PublicKey())
+	shares := make([][]byte, n)
+	for i := range shares {
+		x := byte(i + 1)
+		value := make([]byte, len(secret))
+		for j, s := range secret {
+			// Horner's method, from the highest degree
+			c := coeffs[j*(threshold-1) : (j+1)*(threshold-1)]
+			var y byte
+			for k := len(c) - 1; k >= 0; k-- {
+				y = gfMul(y, x) ^ c[k]
+			}
+			value[j] = gfMul(y, x) ^ s
+		}
+		shares[i] = (&Share{
+			KeyType:     sk.KeyType(),
+			Threshold:   threshold,
+			Index:       int(x),
+			fingerprint: fp,
+			value:       value,
+		}).Bytes()
+		zero(value)
+	}
+	return shares, nil
+}
+
+// Combine reconstructs the private key from the shares, at least threshold shares
+// are needed
+func Combine(shares [][]byte) (crypto.PrivateKey, error) {
+	if len(shares) == 0 {
+		return nil, errors.Wrap(ErrTooFewShares, "no share")
+	}
+	parsed := make([]*Share, 0, len(shares))
+	seen := make(map[int]bool)
+	for i, b := range shares {
+		s, err := ParseShare(b)
+		if err != nil {
+			return nil, errors.Wrapf(err, "share %d", i)
+		}
+		if len(parsed) > 0 && !parsed[0].sameKey(s) {
+			return nil, errors.Wrapf(ErrShareMismatch, "share %d", i)
+		}
+		if seen[s.Index] {
+			return nil, errors.Wrapf(ErrDuplicateShare, "index %d", s.Index)
+		}
+		seen[s.Index] = true
+		parsed = append(parsed, s)
+	}
+	threshold := parsed[0].Threshold
+	if len(parsed) < threshold {
+		return nil, errors.Wrapf(ErrTooFewShares, "need %d shares, got %d", threshold, len(parsed))
+	}
+	parsed = parsed[:threshold]
+
+	// Lagrange interpolation at x = 0
+	secret := make([]byte, len(parsed[0].value))
+	defer zero(secret)
+	for i, si := range parsed {
+		// basis = prod(x_j / (x_j - x_i)), subtraction is xor in GF(2^8)
+		basis := byte(1)
+		for j, sj := range parsed {
+			if i != j {
+				xj := byte(sj.Index)
+				basis = gfMul(basis, gfMul(xj, gfInv(xj^byte(si.Index))))
+			}
+		}
+		for k, y := range si.value {
+			secret[k] ^= gfMul(y, basis)
+		}
+	}
+
+	sk, err := crypto.DecodePrivateKey(secret)
+	if err != nil {
+		return nil, errors.Wrap(ErrInvalidShare, "reconstructed key is invalid")
+	}
+	if sk.KeyType() != parsed[0].KeyType || !bytes.Equal(fingerprint(sk.PublicKey()), parsed[0].fingerprint) {
+		sk.Zero()
+		return nil, errors.Wrap(ErrInvalidShare, "reconstructed key does not match fingerprint")
+	}
+	return sk, nil
+}
+
+// ParseShare decodes the share and verifies its checksum
+func ParseShare(b []byte) (*Share, error) {
+	if len(b) <= shareHeaderSize+checksumSize {
+		return nil, errors.Wrapf(ErrInvalidShare, "invalid length %d", len(b))
+	}
+	body := b[:len(b)-checksumSize]
+	if !bytes.Equal(checksum(body), b[len(body):]) {
+		return nil, errors.Wrap(ErrInvalidShare, "checksum mismatch")
+	}
+	if body[0] != shareVersion {
+		return nil, errors.Wrapf(ErrInvalidShare, "unsupported version %d", body[0])
+	}
+	s := &Share{
+		KeyType:     crypto.KeyType(body[1]),
+		Threshold:   int(body[2]),
+		Index:       int(body[3]),
+		fingerprint: append([]byte{}, body[4:shareHeaderSize]...),
+		value:       append([]byte{}, body[shareHeaderSize:]...),
+	}
+	if s.Threshold < 2 || s.Index == 0 {
+		return nil, errors.Wrap(ErrInvalidShare, "invalid threshold or index")
+	}
+	return s, nil
+}
+
+// Bytes encodes the share with checksum
+func (s *Share) Bytes() []byte {
+	b := make([]byte, 0, shareHeaderSize+len(s.value)+checksumSize)
+	b = append(b, shareVersion, byte(s.KeyType), byte(s.Threshold), byte(s.Index))
+	b = append(b, s.fingerprint...)
+	b = append(b, s.value...)
+	return append(b, checksum(b)...)
+}
+
+func (s *Share) sameKey(o *Share) bool {
+	return s.KeyType == o.KeyType && s.Threshold == o.Threshold &&
+		bytes.Equal(s.fingerprint, o.fingerprint) && len(s.value) == len(o.value)
+}
+
+func fingerprint(pk crypto.PublicKey) []byte {
+	h := sha256.Sum256(pk.Bytes())
+	return h[:fingerprintSize]
+}
+
+func checksum(b []byte) []byte {
+	h := sha256.Sum256(b)
+	return h[:checksumSize]
+}
+
+func zero(b []byte) {
+	for i := range b {
+		b[i] = 0
+	}
+}
+
+//======================================
+// GF(2^8) arithmetic, with the AES polynomial x^8 + x^4 + x^3 + x + 1
+//======================================
+
+// gfMul multiplies in constant time
+func gfMul(a, b byte) byte {
+	var p byte
+	for i := 0; i < 8; i++ {
+		p ^= -(b & 1) & a
+		// reduce by the polynomial if the high bit is set
+		a = (a << 1) ^ (-(a >> 7) & 0x1b)
+		b >>= 1
+	}
+	return p
+}
+
+// gfInv returns a^254, which is the inverse of non-zero a
+func gfInv(a byte) byte {
+	r := a
+	for i := 0; i < 6; i++ {
+		r = gfMul(gfMul(r, r), a)
+	}
+	return gfMul(r, r)
+}
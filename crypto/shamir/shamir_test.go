@@ -0,0 +1,100 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package shamir
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/crypto"
+)
+
+func TestGF256(t *testing.T) {
+	require := require.New(t)
+
+	require.Equal(byte(0xc1), gfMul(0x57, 0x83))
+	require.Equal(byte(0xfe), gfMul(0x57, 0x13))
+	for a := 1; a < 256; a++ {
+		require.Equal(byte(1), gfMul(byte(a), gfInv(byte(a))))
+	}
+}
+
+func TestSplitCombine(t *testing.T) {
+	require := require.New(t)
+
+	for _, gen := range []func() (crypto.PrivateKey, error){
+		crypto.GenerateKey,
+		crypto.GenerateKeySm2,
+		crypto.GenerateKeyEd25519,
+	} {
+		sk, err := gen()
+		require.NoError(err)
+		shares, err := Split(sk, 3, 5)
+		require.NoError(err)
+		require.Len(shares, 5)
+
+		for i, b := range shares {
+			s, err := ParseShare(b)
+			require.NoError(err)
+			require.Equal(sk.KeyType(), s.KeyType)
+			require.Equal(3, s.Threshold)
+			require.Equal(i+1, s.Index)
+		}
+
+		// any 3 shares
+		for _, idx := range [][]int{{0, 1, 2}, {4, 2, 0}, {1, 3, 4}, {0, 1, 2, 3, 4}} {
+			var subset [][]byte
+			for _, i := range idx {
+				subset = append(subset, shares[i])
+			}
+			sk1, err := Combine(subset)
+			require.NoError(err)
+			require.Equal(sk.Bytes(), sk1.Bytes())
+			require.Equal(sk.KeyType(), sk1.KeyType())
+		}
+
+		// too few shares
+		_, err = Combine(shares[:2])
+		require.ErrorIs(err, ErrTooFewShares)
+		_, err = Combine(nil)
+		require.ErrorIs(err, ErrTooFewShares)
+
+		// duplicate share
+		_, err = Combine([][]byte{shares[0], shares[1], shares[1]})
+		require.ErrorIs(err, ErrDuplicateShare)
+
+		// corrupt share
+		corrupt := append([]byte{}, shares[2]...)
+		corrupt[10] ^= 1
+		_, err = Combine([][]byte{shares[0], shares[1], corrupt})
+		require.ErrorIs(err, ErrInvalidShare)
+		_, err = ParseShare(shares[0][:10])
+		require.ErrorIs(err, ErrInvalidShare)
+
+		// shares of another key
+		other, err := gen()
+		require.NoError(err)
+		otherShares, err := Split(other, 3, 5)
+		require.NoError(err)
+		_, err = Combine([][]byte{shares[0], shares[1], otherShares[2]})
+		require.ErrorIs(err, ErrShareMismatch)
+	}
+
+	// invalid threshold
+	sk, err := crypto.GenerateKey()
+	require.NoError(err)
+	for _, v := range [][2]int{{1, 3}, {4, 3}, {2, 256}} {
+		_, err = Split(sk, v[0], v[1])
+		require.ErrorIs(err, ErrInvalidThreshold)
+	}
+	shares, err := Split(sk, 2, 2)
+	require.NoError(err)
+	sk1, err := Combine(shares)
+	require.NoError(err)
+	require.Equal(sk.Bytes(), sk1.Bytes())
+}
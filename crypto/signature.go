@@ -0,0 +1,253 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"encoding/asn1"
+	"encoding/hex"
+	"math/big"
+
+	"github.com/dustinxie/gmsm/sm2"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/go-pkgs/util"
+)
+
+// SigAlgorithm is the algorithm of signature
+type SigAlgorithm string
+
+// supported signature algorithms
+const (
+	SigSecp256k1 SigAlgorithm = "secp256k1"
+	SigP256sm2   SigAlgorithm = "p256sm2"
+)
+
+// Signature is a parsed ECDSA-style signature (R, S) with optional recovery id V
+//
+// the layout is detected by ParseSignature as follows:
+//   - 64-byte R || S: secp256k1 without recovery id
+//   - 65-byte R || S || V, V = 0/1 or 27/28: secp256k1
+//   - 65-byte R || S || V, V = P256sm2RecIDOffset + 0..3: recoverable P256sm2
+//   - ASN.1 DER: P256sm2
+//
+// the parsed signature is verified by PublicKey.VerifySignature, and the public key
+// is recovered by RecoverPubkeyFromSignature
+type Signature struct {
+	alg  SigAlgorithm
+	r, s *big.Int
+	v    byte
+	hasV bool
+}
+
+// ParseSignature parses the signature in any layout listed in Signature
+//
+// DER does not tell the curve, it is taken as P256sm2, use ParseSignatureDER for
+// a secp256k1 signature in DER
+func ParseSignature(b []byte) (*Signature, error) {
+	sig := &Signature{}
+	switch {
+	case len(b) == Secp256k1SigSize:
+		sig.alg = SigSecp256k1
+	case isP256sm2SigWithRecID(b):
+		sig.alg = SigP256sm2
+		sig.v, sig.hasV = b[64]-P256sm2RecIDOffset, true
+	case len(b) == Secp256k1SigSizeWithRecID:
+		v := b[Secp256k1SigSize]
+		if v >= 27 {
+			v -= 27
+		}
+		if v > 1 {
+			return nil, errors.Wrapf(ErrInvalidKey, "invalid recovery id %d", b[Secp256k1SigSize])
+		}
+		sig.alg = SigSecp256k1
+		sig.v, sig.hasV = v, true
+	default:
+		return ParseSignatureDER(b, SigP256sm2)
+	}
+	sig.r = new(big.Int).SetBytes(b[:32])
+	sig.s = new(big.Int).SetBytes(b[32:64])
+	return sig, sig.checkRange()
+}
+
+// ParseSignatureDER parses the signature in ASN.1 DER of the algorithm
+func ParseSignatureDER(b []byte, alg SigAlgorithm) (*Signature, error) {
+	if alg != SigSecp256k1 && alg != SigP256sm2 {
+		return nil, errors.Errorf("unsupported signature algorithm %s", alg)
+	}
+	var der p256sm2Sig
+	rest, err := asn1.Unmarshal(b, &der)
+	if err != nil || len(rest) > 0 {
+		return nil, errors.Wrap(ErrInvalidKey, "unknown signature format")
+	}
+	sig := &Signature{
+		alg: alg,
+		r:   der.R,
+		s:   der.S,
+	}
+	return sig, sig.checkRange()
+}
+
+func (sig *Signature) checkRange() error {
+	n := secp256k1N
+	if sig.alg == SigP256sm2 {
+		n = sm2.P256Sm2().Params().N
+	}
+	if sig.r.Sign() <= 0 || sig.s.Sign() <= 0 || sig.r.Cmp(n) >= 0 || sig.s.Cmp(n) >= 0 {
+		return errors.Wrap(ErrInvalidKey, "r or s out of range")
+	}
+	return nil
+}
+
+// isZero tells nil or the zero value of Signature, which is not parsed from any
+// signature
+func (sig *Signature) isZero() bool {
+	return sig == nil || sig.r == nil || sig.s == nil
+}
+
+// HexStringToSignature decodes a hex string to Signature
+func HexStringToSignature(s string) (*Signature, error) {
+	b, err := hex.DecodeString(util.Remove0xPrefix(s))
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to decode signature %s", s)
+	}
+	return ParseSignature(b)
+}
+
+// Algorithm returns the algorithm of signature
+func (sig *Signature) Algorithm() SigAlgorithm {
+	return sig.alg
+}
+
+// R returns the R value, which is 0 for the zero value of Signature
+func (sig *Signature) R() *big.Int {
+	if sig.r == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(sig.r)
+}
+
+// S returns the S value, which is 0 for the zero value of Signature
+func (sig *Signature) S() *big.Int {
+	if sig.s == nil {
+		return new(big.Int)
+	}
+	return new(big.Int).Set(sig.s)
+}
+
+// V returns the recovery id without any offset, and whether the signature has it
+func (sig *Signature) V() (byte, bool) {
+	return sig.v, sig.hasV
+}
+
+// Bytes returns the signature in the layout accepted by PublicKey.Verify and
+// RecoverPubkey, which is R || S || V for secp256k1 and recoverable P256sm2
+// signature, and DER for P256sm2 signature
+//
+// secp256k1 signature without recovery id is returned in 64-byte R || S, which
+// can neither be verified by PublicKey.Verify nor recovered from, use
+// PublicKey.VerifySignature instead
+func (sig *Signature) Bytes() []byte {
+	switch {
+	case !sig.hasV && sig.alg == SigP256sm2:
+		b, _ := sig.DER()
+		return b
+	case !sig.hasV:
+		return sig.Compact()
+	case sig.alg == SigP256sm2:
+		return append(sig.Compact(), sig.v+P256sm2RecIDOffset)
+	default:
+		return append(sig.Compact(), sig.v)
+	}
+}
+
+// Compact returns the signature in 64-byte R || S
+func (sig *Signature) Compact() []byte {
+	b := make([]byte, Secp256k1SigSize)
+	sig.R().FillBytes(b[:32])
+	sig.S().FillBytes(b[32:])
+	return b
+}
+
+// Ethereum returns the secp256k1 signature in 65-byte R || S || V, where V is 27/28
+func (sig *Signature) Ethereum() ([]byte, error) {
+	if sig.alg != SigSecp256k1 || !sig.hasV {
+		return nil, errors.New("only secp256k1 signature with recovery id has Ethereum format")
+	}
+	return append(sig.Compact(), sig.v+27), nil
+}
+
+// DER returns the signature in ASN.1 DER, recoverable P256sm2 signature has no
+// DER format as it is not a standard SM2 signature
+//
+// DER does not keep the algorithm, parse it back with ParseSignatureDER
+func (sig *Signature) DER() ([]byte, error) {
+	if sig.isZero() {
+		return nil, errors.New("empty signature")
+	}
+	if sig.alg == SigP256sm2 && sig.hasV {
+		return nil, errors.New("recoverable p256sm2 signature has no DER format")
+	}
+	return asn1.Marshal(p256sm2Sig{sig.r, sig.s})
+}
+
+// HexString returns the signature in hex string
+func (sig *Signature) HexString() string {
+	return hex.EncodeToString(sig.Bytes())
+}
+
+// Verify verifies the signature of hash against the public key, the algorithm of
+// signature must match the key type
+func (sig *Signature) Verify(pk PublicKey, hash []byte) bool {
+	if sig.isZero() {
+		return false
+	}
+	switch pk.(type) {
+	case *secp256k1PubKey:
+		if sig.alg != SigSecp256k1 {
+			return false
+		}
+	case *P256sm2PubKey:
+		if sig.alg != SigP256sm2 {
+			return false
+		}
+	default:
+		return false
+	}
+	if sig.alg == SigSecp256k1 && !sig.hasV {
+		// PublicKey.Verify only takes 65-byte secp256k1 signature, verify R || S directly
+		k, ok := pk.(*secp256k1PubKey)
+		return ok && crypto.VerifySignature(k.Bytes(), hash, sig.Compact())
+	}
+	return pk.Verify(hash, sig.Bytes())
+}
+
+// RecoverPubkey recovers the public key from the signature of hash
+func (sig *Signature) RecoverPubkey(hash []byte) (PublicKey, error) {
+	if sig.isZero() || !sig.hasV {
+		return nil, errors.Wrap(ErrInvalidKey, "signature has no recovery id")
+	}
+	return RecoverPubkey(hash, sig.Bytes())
+}
+
+// MarshalText encodes the signature to hex string, which also applies to JSON
+func (sig *Signature) MarshalText() ([]byte, error) {
+	if sig.isZero() {
+		return nil, errors.New("empty signature")
+	}
+	return []byte(sig.HexString()), nil
+}
+
+// UnmarshalText decodes the signature from hex string, with or without 0x prefix
+func (sig *Signature) UnmarshalText(text []byte) error {
+	s, err := HexStringToSignature(string(text))
+	if err != nil {
+		return err
+	}
+	*sig = *s
+	return nil
+}
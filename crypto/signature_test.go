@@ -0,0 +1,192 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+func TestSignatureSecp256k1(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := GenerateKey()
+	require.NoError(err)
+	pk := sk.PublicKey()
+	h := hash.Hash256b([]byte("test signature"))
+	b, err := sk.Sign(h[:])
+	require.NoError(err)
+
+	sig, err := ParseSignature(b)
+	require.NoError(err)
+	require.Equal(SigSecp256k1, sig.Algorithm())
+	require.Equal(new(big.Int).SetBytes(b[:32]), sig.R())
+	require.Equal(new(big.Int).SetBytes(b[32:64]), sig.S())
+	v, ok := sig.V()
+	require.True(ok)
+	require.Equal(b[64], v)
+	require.Equal(b, sig.Bytes())
+	require.Equal(b[:64], sig.Compact())
+	require.True(sig.Verify(pk, h[:]))
+	require.True(pk.VerifySignature(h[:], sig))
+	pk1, err := sig.RecoverPubkey(h[:])
+	require.NoError(err)
+	require.Equal(pk.Bytes(), pk1.Bytes())
+	pk1, err = RecoverPubkeyFromSignature(h[:], sig)
+	require.NoError(err)
+	require.Equal(pk.Bytes(), pk1.Bytes())
+
+	// Ethereum format converts back to the same signature
+	eth, err := sig.Ethereum()
+	require.NoError(err)
+	require.Equal(b[64]+27, eth[64])
+	sig1, err := ParseSignature(eth)
+	require.NoError(err)
+	require.Equal(sig, sig1)
+
+	// DER format keeps the algorithm with ParseSignatureDER
+	der, err := sig.DER()
+	require.NoError(err)
+	require.Equal(byte(0x30), der[0])
+	sig1, err = ParseSignatureDER(der, SigSecp256k1)
+	require.NoError(err)
+	require.Equal(SigSecp256k1, sig1.Algorithm())
+	require.Equal(sig.Compact(), sig1.Compact())
+	require.True(sig1.Verify(pk, h[:]))
+	sig1, err = ParseSignature(der)
+	require.NoError(err)
+	require.Equal(SigP256sm2, sig1.Algorithm())
+	_, err = ParseSignatureDER(der, "ed25519")
+	require.Error(err)
+	_, err = ParseSignatureDER(append(der, 0), SigSecp256k1)
+	require.ErrorIs(err, ErrInvalidKey)
+
+	// compact format has no recovery id
+	sig1, err = ParseSignature(b[:64])
+	require.NoError(err)
+	_, ok = sig1.V()
+	require.False(ok)
+	require.Equal(b[:64], sig1.Bytes())
+	_, err = sig1.Ethereum()
+	require.Error(err)
+	_, err = sig1.RecoverPubkey(h[:])
+	require.ErrorIs(err, ErrInvalidKey)
+	_, err = RecoverPubkeyFromSignature(h[:], sig1)
+	require.ErrorIs(err, ErrInvalidKey)
+	require.True(sig1.Verify(pk, h[:]))
+	require.True(pk.VerifySignature(h[:], sig1))
+	require.False(sig1.Verify(pk, h[1:]))
+	sk2, err := GenerateKey()
+	require.NoError(err)
+	require.False(sig1.Verify(sk2.PublicKey(), h[:]))
+	sk3, err := GenerateKeySm2()
+	require.NoError(err)
+	require.False(sig1.Verify(sk3.PublicKey(), h[:]))
+	require.False(sk3.PublicKey().VerifySignature(h[:], sig1))
+	sk4, err := GenerateKeyEd25519()
+	require.NoError(err)
+	require.False(sk4.PublicKey().VerifySignature(h[:], sig1))
+
+	// zero value
+	var zero Signature
+	require.Zero(zero.R().Sign())
+	require.Equal(make([]byte, 64), zero.Compact())
+	require.False(zero.Verify(pk, h[:]))
+	require.False(pk.VerifySignature(h[:], &zero))
+	require.False(pk.VerifySignature(h[:], nil))
+	_, err = RecoverPubkeyFromSignature(h[:], nil)
+	require.Error(err)
+	_, err = zero.DER()
+	require.Error(err)
+	_, err = zero.RecoverPubkey(h[:])
+	require.Error(err)
+	_, err = zero.MarshalText()
+	require.Error(err)
+
+	// hex and JSON
+	sig1, err = HexStringToSignature("0x" + sig.HexString())
+	require.NoError(err)
+	require.Equal(sig, sig1)
+	js, err := json.Marshal(struct{ Sig *Signature }{sig})
+	require.NoError(err)
+	require.Equal(`{"Sig":"`+sig.HexString()+`"}`, string(js))
+	var out struct{ Sig *Signature }
+	require.NoError(json.Unmarshal(js, &out))
+	require.Equal(sig, out.Sig)
+
+	// invalid signature
+	for _, v := range [][]byte{
+		b[:63],
+		append(b[:64:64], 2),
+		make([]byte, 64),
+		{0x30, 0x01},
+	} {
+		_, err = ParseSignature(v)
+		require.ErrorIs(err, ErrInvalidKey)
+	}
+	_, err = HexStringToSignature("xyz")
+	require.Error(err)
+	require.Error(json.Unmarshal([]byte(`{"Sig":"00"}`), &out))
+}
+
+func TestSignatureP256sm2(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := GenerateKeySm2()
+	require.NoError(err)
+	pk := sk.PublicKey()
+	h := hash.Hash256b([]byte("test signature"))
+	b, err := sk.Sign(h[:])
+	require.NoError(err)
+
+	sig, err := ParseSignature(b)
+	require.NoError(err)
+	require.Equal(SigP256sm2, sig.Algorithm())
+	_, ok := sig.V()
+	require.False(ok)
+	require.Equal(b, sig.Bytes())
+	der, err := sig.DER()
+	require.NoError(err)
+	require.Equal(b, der)
+	require.True(sig.Verify(pk, h[:]))
+	require.True(pk.VerifySignature(h[:], sig))
+	_, err = sig.Ethereum()
+	require.Error(err)
+	_, err = sig.RecoverPubkey(h[:])
+	require.ErrorIs(err, ErrInvalidKey)
+
+	// trailing data is rejected
+	_, err = ParseSignature(append(b, 0))
+	require.ErrorIs(err, ErrInvalidKey)
+
+	// recoverable signature
+	b, err = sk.(*P256sm2PrvKey).SignWithOptions(h[:], WithRecoveryID())
+	require.NoError(err)
+	sig, err = ParseSignature(b)
+	require.NoError(err)
+	require.Equal(SigP256sm2, sig.Algorithm())
+	v, ok := sig.V()
+	require.True(ok)
+	require.Equal(b[64]-P256sm2RecIDOffset, v)
+	require.Equal(b, sig.Bytes())
+	require.Equal(b[:64], sig.Compact())
+	require.True(pk.VerifySignature(h[:], sig))
+	pk1, err := RecoverPubkeyFromSignature(h[:], sig)
+	require.NoError(err)
+	require.Equal(pk.Bytes(), pk1.Bytes())
+	_, err = sig.DER()
+	require.Error(err)
+
+	var sig1 Signature
+	require.NoError(sig1.UnmarshalText([]byte(sig.HexString())))
+	require.Equal(*sig, sig1)
+}
@@ -0,0 +1,245 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"bytes"
+	"encoding/asn1"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+var (
+	// ErrNonCanonicalSig indicates the signature is valid but not in canonical form
+	ErrNonCanonicalSig = errors.New("non-canonical signature")
+
+	secp256k1N     = crypto.S256().Params().N
+	secp256k1HalfN = new(big.Int).Rsh(secp256k1N, 1)
+)
+
+type (
+	// SigPolicy is the policy against signature malleability, the zero value does not
+	// check canonical form
+	//
+	// Verify of the zero value differs from PublicKey.Verify in that the recovery id
+	// must match the public key, and 64-byte secp256k1 signature is accepted
+	SigPolicy struct {
+		lowS          bool
+		rejectVOffset bool
+		strictDER     bool
+	}
+
+	// SigPolicyOption is an option of SigPolicy
+	SigPolicyOption func(*SigPolicy)
+
+	// p256sm2Sig is the ASN.1 structure of SM2 signature
+	p256sm2Sig struct {
+		R, S *big.Int
+	}
+)
+
+// RequireLowS requires s <= N/2 for secp256k1 signature, per EIP-2
+//
+// PublicKey.Verify already rejects high-S signature, while RecoverPubkey does not
+func RequireLowS() SigPolicyOption {
+	return func(p *SigPolicy) {
+		p.lowS = true
+	}
+}
+
+// RejectVOffset rejects the secp256k1 recovery id of 27/28, only 0/1 is accepted
+func RejectVOffset() SigPolicyOption {
+	return func(p *SigPolicy) {
+		p.rejectVOffset = true
+	}
+}
+
+// RequireCanonicalDER requires P256sm2 signature in canonical DER encoding, without
+// trailing data
+func RequireCanonicalDER() SigPolicyOption {
+	return func(p *SigPolicy) {
+		p.strictDER = true
+	}
+}
+
+// NewSigPolicy creates a signature policy
+func NewSigPolicy(opts ...SigPolicyOption) *SigPolicy {
+	p := &SigPolicy{}
+	for _, opt := range opts {
+		opt(p)
+	}
+	return p
+}
+
+// NewStrictSigPolicy creates a signature policy with all options on, which only
+// accepts the signature in the form output by Normalize
+func NewStrictSigPolicy() *SigPolicy {
+	return NewSigPolicy(RequireLowS(), RejectVOffset(), RequireCanonicalDER())
+}
+
+// Verify checks the signature against the policy, and verifies it
+//
+// for secp256k1 and P256sm2 signature with recovery id, the recovery id must also
+// match the public key, which PublicKey.Verify does not check. 64-byte secp256k1
+// signature is verified by R and S only, which PublicKey.Verify rejects
+func (p *SigPolicy) Verify(pk PublicKey, hash, sig []byte) bool {
+	switch pk.(type) {
+	case *secp256k1PubKey:
+		if p.checkSecp256k1(sig) != nil {
+			return false
+		}
+		if len(sig) == Secp256k1SigSize {
+			return crypto.VerifySignature(pk.Bytes(), hash, sig)
+		}
+		if !pk.Verify(hash, sig) {
+			return false
+		}
+		// RecoverPubkey may temporarily modify the signature, make a copy
+		sig = append([]byte{}, sig...)
+		pk1, err := recoverSecp256k1(hash, sig)
+		return err == nil && bytes.Equal(pk.Bytes(), pk1.Bytes())
+	case *P256sm2PubKey:
+		if p.checkP256sm2(sig) != nil || !pk.Verify(hash, sig) {
+			return false
+		}
+		if !isP256sm2SigWithRecID(sig) {
+			return true
+		}
+		// each of the 4 recovery ids passes pk.Verify, only the one of pk is accepted
+		pk1, err := recoverP256sm2(hash, sig)
+		return err == nil && bytes.Equal(pk.Bytes(), pk1.Bytes())
+	default:
+		return pk.Verify(hash, sig)
+	}
+}
+
+// RecoverPubkey checks the signature against the policy, and recovers the public key
+func (p *SigPolicy) RecoverPubkey(hash, sig []byte) (PublicKey, error) {
+	if isP256sm2SigWithRecID(sig) {
+		if err := p.checkP256sm2(sig); err != nil {
+			return nil, err
+		}
+	} else if err := p.checkSecp256k1(sig); err != nil {
+		return nil, err
+	}
+	return RecoverPubkey(hash, sig)
+}
+
+func (p *SigPolicy) checkSecp256k1(sig []byte) error {
+	if len(sig) != Secp256k1SigSize && len(sig) != Secp256k1SigSizeWithRecID {
+		return errors.Wrapf(ErrInvalidKey, "invalid secp256k1 signature length %d", len(sig))
+	}
+	if len(sig) == Secp256k1SigSizeWithRecID {
+		v := sig[Secp256k1SigSize]
+		if v >= 27 {
+			if p.rejectVOffset {
+				return errors.Wrapf(ErrNonCanonicalSig, "recovery id %d", v)
+			}
+			v -= 27
+		}
+		if v > 1 {
+			return errors.Wrapf(ErrInvalidKey, "invalid recovery id %d", sig[Secp256k1SigSize])
+		}
+	}
+	if p.lowS && new(big.Int).SetBytes(sig[32:Secp256k1SigSize]).Cmp(secp256k1HalfN) > 0 {
+		return errors.Wrap(ErrNonCanonicalSig, "s is greater than N/2")
+	}
+	return nil
+}
+
+func (p *SigPolicy) checkP256sm2(sig []byte) error {
+	if isP256sm2SigWithRecID(sig) || !p.strictDER {
+		return nil
+	}
+	b, err := canonicalP256sm2DER(sig)
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(b, sig) {
+		return errors.Wrap(ErrNonCanonicalSig, "non-canonical DER encoding")
+	}
+	return nil
+}
+
+// Normalize rewrites the secp256k1 ECDSA or P256sm2 signature of pk into canonical
+// form, and verifies it:
+//   - for secp256k1, s is replaced by N - s if s > N/2 and the recovery id is
+//     flipped accordingly, and 27/28 recovery id is converted to 0/1
+//   - for P256sm2, DER encoding is re-encoded canonically without trailing data,
+//     and the recovery id is replaced by the one that recovers pk
+//
+// the canonical form is accepted by NewStrictSigPolicy. Other signatures of the same
+// length, such as BIP-340 Schnorr signature, are not verified and return error
+func Normalize(pk PublicKey, hash, sig []byte) ([]byte, error) {
+	var (
+		b   []byte
+		err error
+	)
+	switch pk.(type) {
+	case *secp256k1PubKey:
+		b, err = normalizeSecp256k1(sig)
+	case *P256sm2PubKey:
+		b, err = normalizeP256sm2(pk, hash, sig)
+	default:
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported public key type %T", pk)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if !NewStrictSigPolicy().Verify(pk, hash, b) {
+		return nil, errors.Wrap(ErrInvalidKey, "invalid signature")
+	}
+	return b, nil
+}
+
+func normalizeSecp256k1(sig []byte) ([]byte, error) {
+	if err := (&SigPolicy{}).checkSecp256k1(sig); err != nil {
+		return nil, err
+	}
+	b := append([]byte{}, sig...)
+	if len(b) == Secp256k1SigSizeWithRecID && b[Secp256k1SigSize] >= 27 {
+		b[Secp256k1SigSize] -= 27
+	}
+	s := new(big.Int).SetBytes(b[32:Secp256k1SigSize])
+	if s.Cmp(secp256k1HalfN) > 0 {
+		s.Sub(secp256k1N, s).FillBytes(b[32:Secp256k1SigSize])
+		if len(b) == Secp256k1SigSizeWithRecID {
+			b[Secp256k1SigSize] ^= 1
+		}
+	}
+	return b, nil
+}
+
+func normalizeP256sm2(pk PublicKey, hash, sig []byte) ([]byte, error) {
+	if !isP256sm2SigWithRecID(sig) {
+		return canonicalP256sm2DER(sig)
+	}
+	// (r, s) of SM2 is not malleable like ECDSA, as s is bound to r in verification,
+	// while the recovery id is not covered by verification
+	b := append([]byte{}, sig...)
+	for v := byte(0); v < 4; v++ {
+		b[P256sm2SigSizeWithRecID-1] = P256sm2RecIDOffset + v
+		if pk1, err := recoverP256sm2(hash, b); err == nil && bytes.Equal(pk.Bytes(), pk1.Bytes()) {
+			return b, nil
+		}
+	}
+	return nil, errors.Wrap(ErrInvalidKey, "no recovery id matches the public key")
+}
+
+// canonicalP256sm2DER decodes the DER signature and re-encodes it canonically
+func canonicalP256sm2DER(sig []byte) ([]byte, error) {
+	var s p256sm2Sig
+	if _, err := asn1.Unmarshal(sig, &s); err != nil {
+		return nil, errors.Wrap(ErrInvalidKey, err.Error())
+	}
+	if s.R.Sign() <= 0 || s.S.Sign() <= 0 {
+		return nil, errors.Wrap(ErrInvalidKey, "invalid p256sm2 signature")
+	}
+	return asn1.Marshal(s)
+}
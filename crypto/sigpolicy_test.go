@@ -0,0 +1,174 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+func TestSigPolicySecp256k1(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := GenerateKey()
+	require.NoError(err)
+	pk := sk.PublicKey()
+	h := hash.Hash256b([]byte("test malleability"))
+	sig, err := sk.Sign(h[:])
+	require.NoError(err)
+
+	// malleate s to N - s, and flip the recovery id
+	high := append([]byte{}, sig...)
+	s := new(big.Int).SetBytes(sig[32:64])
+	s.Sub(secp256k1N, s).FillBytes(high[32:64])
+	high[64] ^= 1
+	// verification rejects high-S, while recovery does not
+	require.False(pk.Verify(h[:], high))
+	pk1, err := RecoverPubkey(h[:], high)
+	require.NoError(err)
+	require.Equal(pk.Bytes(), pk1.Bytes())
+	// v offset
+	offset := append([]byte{}, sig...)
+	offset[64] += 27
+	// wrong recovery id
+	wrongV := append([]byte{}, sig...)
+	wrongV[64] ^= 1
+	require.True(pk.Verify(h[:], wrongV))
+
+	var (
+		lax    = NewSigPolicy()
+		strict = NewStrictSigPolicy()
+	)
+	for _, v := range []struct {
+		sig            []byte
+		lax, strict    bool
+		normalizeToSig bool
+	}{
+		{sig, true, true, true},
+		// 64-byte signature is verified by R and S
+		{sig[:64], true, true, false},
+		{high, false, false, true},
+		{high[:64], false, false, false},
+		{offset, true, false, true},
+		{wrongV, false, false, false},
+	} {
+		require.Equal(v.lax, lax.Verify(pk, h[:], v.sig))
+		require.False(lax.Verify(pk, h[1:], v.sig))
+		require.Equal(v.strict, strict.Verify(pk, h[:], v.sig))
+		if v.normalizeToSig {
+			b, err := Normalize(pk, h[:], v.sig)
+			require.NoError(err)
+			require.Equal(sig, b)
+			require.True(strict.Verify(pk, h[:], b))
+			_, err = strict.RecoverPubkey(h[:], v.sig)
+			if v.strict {
+				require.NoError(err)
+			} else {
+				require.ErrorIs(err, ErrNonCanonicalSig)
+			}
+			pk1, err = lax.RecoverPubkey(h[:], v.sig)
+			require.NoError(err)
+			require.Equal(pk.Bytes(), pk1.Bytes())
+		}
+	}
+
+	b, err := Normalize(pk, h[:], high[:64])
+	require.NoError(err)
+	require.Equal(sig[:64], b)
+	// each option alone
+	require.False(NewSigPolicy(RequireLowS()).Verify(pk, h[:], high))
+	require.True(NewSigPolicy(RequireLowS()).Verify(pk, h[:], offset))
+	require.False(NewSigPolicy(RejectVOffset()).Verify(pk, h[:], offset))
+	_, err = NewSigPolicy(RejectVOffset()).RecoverPubkey(h[:], high)
+	require.NoError(err)
+	_, err = NewSigPolicy(RequireLowS()).RecoverPubkey(h[:], high)
+	require.ErrorIs(err, ErrNonCanonicalSig)
+
+	// invalid signature
+	_, err = Normalize(pk, h[:], sig[:63])
+	require.Error(err)
+	invalidV := append([]byte{}, sig...)
+	invalidV[64] = 2
+	_, err = Normalize(pk, h[:], invalidV)
+	require.ErrorIs(err, ErrInvalidKey)
+	require.False(lax.Verify(pk, h[:], invalidV))
+	_, err = Normalize(pk, h[1:], sig)
+	require.ErrorIs(err, ErrInvalidKey)
+
+	// 64-byte Schnorr signature is not taken as ECDSA
+	schnorr, err := SchnorrSign(sk, h[:])
+	require.NoError(err)
+	require.True(SchnorrVerify(pk, h[:], schnorr))
+	_, err = Normalize(pk, h[:], schnorr)
+	require.ErrorIs(err, ErrInvalidKey)
+
+	// unsupported key type
+	ed, err := GenerateKeyEd25519()
+	require.NoError(err)
+	edSig, err := ed.Sign(h[:])
+	require.NoError(err)
+	_, err = Normalize(ed.PublicKey(), h[:], edSig)
+	require.ErrorIs(err, ErrInvalidKey)
+}
+
+func TestSigPolicyP256sm2(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := GenerateKeySm2()
+	require.NoError(err)
+	pk := sk.PublicKey()
+	h := hash.Hash256b([]byte("test malleability"))
+	sig, err := sk.Sign(h[:])
+	require.NoError(err)
+
+	var (
+		lax    = NewSigPolicy()
+		strict = NewStrictSigPolicy()
+	)
+	require.True(lax.Verify(pk, h[:], sig))
+	require.True(strict.Verify(pk, h[:], sig))
+
+	// trailing data is ignored by the DER decoder
+	trailing := append(append([]byte{}, sig...), 0)
+	require.True(pk.Verify(h[:], trailing))
+	require.True(lax.Verify(pk, h[:], trailing))
+	require.False(strict.Verify(pk, h[:], trailing))
+	b, err := Normalize(pk, h[:], trailing)
+	require.NoError(err)
+	require.Equal(sig, b)
+	b, err = Normalize(pk, h[:], sig)
+	require.NoError(err)
+	require.Equal(sig, b)
+
+	// recovery id must recover the public key
+	sig, err = sk.(*P256sm2PrvKey).SignWithOptions(h[:], WithRecoveryID())
+	require.NoError(err)
+	require.True(strict.Verify(pk, h[:], sig))
+	pk1, err := strict.RecoverPubkey(h[:], sig)
+	require.NoError(err)
+	require.Equal(pk.Bytes(), pk1.Bytes())
+	for v := byte(0); v < 4; v++ {
+		other := append([]byte{}, sig...)
+		other[64] = P256sm2RecIDOffset + v
+		require.True(pk.Verify(h[:], other))
+		require.Equal(other[64] == sig[64], lax.Verify(pk, h[:], other))
+		require.Equal(other[64] == sig[64], strict.Verify(pk, h[:], other))
+		b, err = Normalize(pk, h[:], other)
+		require.NoError(err)
+		require.Equal(sig, b)
+	}
+	_, err = Normalize(pk, h[1:], sig)
+	require.ErrorIs(err, ErrInvalidKey)
+
+	// invalid DER
+	_, err = Normalize(pk, h[:], []byte{0x30, 0x01})
+	require.ErrorIs(err, ErrInvalidKey)
+}
@@ -0,0 +1,192 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package smt
+
+import (
+	"encoding/binary"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+type (
+	// Proof is the membership or non-membership proof of a key, which is the sibling
+	// hashes on the path from root to the position of key
+	//
+	// for non-membership, the position is either an empty subtree, or the leaf of
+	// another key which shares the path, whose key and value hash are in the proof
+	Proof struct {
+		siblings []hash.Hash256
+		leaf     *proofLeaf
+	}
+
+	proofLeaf struct {
+		key       hash.Hash256
+		valueHash hash.Hash256
+	}
+)
+
+// Prove returns the proof of key, which is a membership proof if the key is in the
+// tree, or a non-membership proof otherwise
+func (t *Tree) Prove(key hash.Hash256) (*Proof, error) {
+	p := &Proof{}
+	h := t.root
+	for d := 0; ; d++ {
+		n, err := t.load(h)
+		if err != nil {
+			return nil, err
+		}
+		switch n.kind {
+		case kindEmpty:
+			return p, nil
+		case kindLeaf:
+			if n.key != key {
+				p.leaf = &proofLeaf{n.key, hash.Hash256b(n.value)}
+			}
+			return p, nil
+		}
+		b := bit(key, d)
+		p.siblings = append(p.siblings, n.child(b^1))
+		h = n.child(b)
+	}
+}
+
+// VerifyMembership verifies the proof that key has value in the tree of root
+func VerifyMembership(root, key hash.Hash256, value []byte, p *Proof) bool {
+	if p == nil || p.leaf != nil || len(value) == 0 || len(p.siblings) > Depth {
+		return false
+	}
+	return p.root(key, leafHash(key, hash.Hash256b(value))) == root
+}
+
+// VerifyNonMembership verifies the proof that key is not in the tree of root
+func VerifyNonMembership(root, key hash.Hash256, p *Proof) bool {
+	if p == nil || len(p.siblings) > Depth {
+		return false
+	}
+	bottom := hash.ZeroHash256
+	if p.leaf != nil {
+		// the leaf of another key must be on the path of key
+		if p.leaf.key == key {
+			return false
+		}
+		for d := range p.siblings {
+			if bit(p.leaf.key, d) != bit(key, d) {
+				return false
+			}
+		}
+		bottom = leafHash(p.leaf.key, p.leaf.valueHash)
+	}
+	return p.root(key, bottom) == root
+}
+
+func (p *Proof) root(key, h hash.Hash256) hash.Hash256 {
+	for d := len(p.siblings) - 1; d >= 0; d-- {
+		if bit(key, d) == 0 {
+			h = internalHash(h, p.siblings[d])
+		} else {
+			h = internalHash(p.siblings[d], h)
+		}
+	}
+	return h
+}
+
+//======================================
+// proof encoding
+//======================================
+
+// Bytes returns the proof in binary, which is
+// flag(1) || depth(2) || bitmap || non-empty siblings || leaf key || leaf value hash
+//
+// bit i of the bitmap (most significant bit first) is set if the sibling at depth i
+// is not empty, only those siblings are included. Flag is 1 if the proof has the leaf
+// of another key, which is omitted otherwise
+func (p *Proof) Bytes() []byte {
+	depth := len(p.siblings)
+	size := 3 + (depth+7)/8
+	b := make([]byte, size, size+(depth+2)*32)
+	if p.leaf != nil {
+		b[0] = 1
+	}
+	binary.BigEndian.PutUint16(b[1:], uint16(depth))
+	for d, s := range p.siblings {
+		if s != hash.ZeroHash256 {
+			b[3+d/8] |= 0x80 >> (d & 7)
+			b = append(b, s[:]...)
+		}
+	}
+	if p.leaf != nil {
+		b = append(b, p.leaf.key[:]...)
+		b = append(b, p.leaf.valueHash[:]...)
+	}
+	return b
+}
+
+// BytesToProof decodes the proof encoded by Proof.Bytes
+func BytesToProof(b []byte) (*Proof, error) {
+	if len(b) < 3 || b[0] > 1 {
+		return nil, ErrInvalidProof
+	}
+	depth := int(binary.BigEndian.Uint16(b[1:]))
+	if depth > Depth {
+		return nil, errors.Wrapf(ErrInvalidProof, "depth %d exceeds %d", depth, Depth)
+	}
+	size := (depth + 7) / 8
+	if len(b) < 3+size {
+		return nil, errors.Wrap(ErrInvalidProof, "bitmap is too short")
+	}
+	bitmap, rest := b[3:3+size], b[3+size:]
+	if depth&7 != 0 && bitmap[len(bitmap)-1]&(0xff>>(depth&7)) != 0 {
+		return nil, errors.Wrap(ErrInvalidProof, "padding bits of bitmap are set")
+	}
+
+	p := &Proof{
+		siblings: make([]hash.Hash256, depth),
+	}
+	for d := range p.siblings {
+		if bitmap[d/8]&(0x80>>(d&7)) == 0 {
+			continue
+		}
+		if len(rest) < 32 {
+			return nil, errors.Wrap(ErrInvalidProof, "missing sibling")
+		}
+		copy(p.siblings[d][:], rest)
+		if p.siblings[d] == hash.ZeroHash256 {
+			return nil, errors.Wrap(ErrInvalidProof, "empty sibling is included")
+		}
+		rest = rest[32:]
+	}
+	if b[0] == 1 {
+		if len(rest) < 64 {
+			return nil, errors.Wrap(ErrInvalidProof, "missing leaf")
+		}
+		p.leaf = &proofLeaf{}
+		copy(p.leaf.key[:], rest)
+		copy(p.leaf.valueHash[:], rest[32:])
+		rest = rest[64:]
+	}
+	if len(rest) != 0 {
+		return nil, errors.Wrap(ErrInvalidProof, "trailing data")
+	}
+	return p, nil
+}
+
+// MarshalBinary encodes the proof in binary
+func (p *Proof) MarshalBinary() ([]byte, error) {
+	return p.Bytes(), nil
+}
+
+// UnmarshalBinary decodes the proof from binary
+func (p *Proof) UnmarshalBinary(b []byte) error {
+	proof, err := BytesToProof(b)
+	if err != nil {
+		return err
+	}
+	*p = *proof
+	return nil
+}
@@ -0,0 +1,137 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package smt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+func TestProof(t *testing.T) {
+	require := require.New(t)
+
+	tr := NewTree(NewMemStore(), hash.ZeroHash256)
+	absent := hash.Hash256b([]byte("absent"))
+
+	// empty tree
+	p, err := tr.Prove(absent)
+	require.NoError(err)
+	require.True(VerifyNonMembership(tr.Root(), absent, p))
+	require.False(VerifyMembership(tr.Root(), absent, []byte("v"), p))
+
+	keys, values := testKV(100)
+	for i := range keys {
+		require.NoError(tr.Update(keys[i], values[i]))
+	}
+	root := tr.Root()
+	for i := range keys {
+		p, err := tr.Prove(keys[i])
+		require.NoError(err)
+		require.True(VerifyMembership(root, keys[i], values[i], p))
+		require.False(VerifyMembership(root, keys[i], []byte("x"), p))
+		require.False(VerifyMembership(root, keys[i], nil, p))
+		require.False(VerifyNonMembership(root, keys[i], p))
+		require.False(VerifyMembership(root, keys[(i+1)%len(keys)], values[i], p))
+		require.False(VerifyMembership(hash.ZeroHash256, keys[i], values[i], p))
+		require.Less(len(p.siblings), 20)
+	}
+
+	// absent keys end at either empty subtree or leaf of another key
+	var withLeaf, withoutLeaf int
+	for i := 0; i < 100; i++ {
+		key := hash.Hash256b([]byte{'a', 'b', byte(i)})
+		p, err := tr.Prove(key)
+		require.NoError(err)
+		require.True(VerifyNonMembership(root, key, p))
+		require.False(VerifyMembership(root, key, []byte("v"), p))
+		if p.leaf != nil {
+			withLeaf++
+			require.False(VerifyNonMembership(root, p.leaf.key, p))
+		} else {
+			withoutLeaf++
+		}
+	}
+	require.NotZero(withLeaf)
+	require.NotZero(withoutLeaf)
+
+	// membership proof cannot be used for non-membership with the leaf of key
+	p, err = tr.Prove(keys[0])
+	require.NoError(err)
+	p.leaf = &proofLeaf{keys[0], hash.Hash256b(values[0])}
+	require.False(VerifyNonMembership(root, keys[0], p))
+	require.False(VerifyNonMembership(root, keys[0], nil))
+	require.False(VerifyMembership(root, keys[0], values[0], nil))
+}
+
+func TestProofEncoding(t *testing.T) {
+	require := require.New(t)
+
+	// keys differ only in the last bit, so the path has 256 siblings
+	var k1, k2, k3 hash.Hash256
+	k1[31], k2[31], k3[0] = 0xf0, 0xf1, 0x80
+	tr := NewTree(NewMemStore(), hash.ZeroHash256)
+	require.NoError(tr.UpdateBatch(map[hash.Hash256][]byte{
+		k1: []byte("a"),
+		k2: []byte("b"),
+		k3: []byte("c"),
+	}))
+	root := tr.Root()
+
+	p, err := tr.Prove(k1)
+	require.NoError(err)
+	require.Len(p.siblings, Depth)
+	b := p.Bytes()
+	// only the siblings at depth 0 and 255 are not empty
+	require.Len(b, 3+32+2*32)
+	require.Equal([]byte{0, 1, 0, 0x80}, b[:4])
+	require.Equal(byte(1), b[3+31])
+	p2, err := BytesToProof(b)
+	require.NoError(err)
+	require.Equal(p, p2)
+	require.True(VerifyMembership(root, k1, []byte("a"), p2))
+
+	// non-membership with leaf
+	var k4 hash.Hash256
+	k4[0] = 0xc0
+	p, err = tr.Prove(k4)
+	require.NoError(err)
+	require.NotNil(p.leaf)
+	b = p.Bytes()
+	require.Len(b, 3+1+32+64)
+	var p3 Proof
+	require.NoError(p3.UnmarshalBinary(b))
+	require.Equal(p, &p3)
+	require.True(VerifyNonMembership(root, k4, &p3))
+
+	// empty tree
+	p, err = NewTree(NewMemStore(), hash.ZeroHash256).Prove(k4)
+	require.NoError(err)
+	require.Equal([]byte{0, 0, 0}, p.Bytes())
+
+	for _, v := range [][]byte{
+		nil,
+		{2, 0, 0},
+		{0, 0x01, 0x01},
+		{0, 0, 1},
+		// padding bit set
+		{0, 0, 1, 0x40},
+		// missing sibling
+		{0, 0, 1, 0x80},
+		// empty sibling
+		append([]byte{0, 0, 1, 0x80}, make([]byte, 32)...),
+		// missing leaf
+		{1, 0, 0},
+		// trailing data
+		{0, 0, 0, 0},
+	} {
+		_, err = BytesToProof(v)
+		require.ErrorIs(err, ErrInvalidProof)
+	}
+}
@@ -0,0 +1,308 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+// Package smt implements the sparse Merkle tree of 256-bit keys, which proves that a
+// key is or is not in the tree against the root
+//
+// a leaf is placed at the shortest prefix of its key that no other key shares,
+// instead of at depth 256, and an empty subtree has hash ZeroHash256, so the tree
+// of n keys has about log(n) depth. The root depends only on the set of keys and
+// values, regardless of the order they are updated
+package smt
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+const (
+	// Depth is the depth of tree, which is the number of bits of key
+	Depth = 256
+
+	// leaf is leafPrefix || key || value, and its hash is
+	// Hash256b(leafPrefix || key || Hash256b(value))
+	//
+	// internal node is internalPrefix || left || right, and its hash is
+	// Hash256b(internalPrefix || left || right)
+	leafPrefix     = 0x00
+	internalPrefix = 0x01
+)
+
+var (
+	// ErrNotFound indicates the node is not in the store
+	ErrNotFound = errors.New("node not found")
+	// ErrKeyNotFound indicates the key is not in the tree
+	ErrKeyNotFound = errors.New("key not found")
+	// ErrInvalidProof indicates the proof encoding is invalid
+	ErrInvalidProof = errors.New("invalid proof")
+)
+
+type (
+	// Tree is the sparse Merkle tree, it is not safe for concurrent use
+	Tree struct {
+		store Store
+		root  hash.Hash256
+	}
+
+	nodeKind uint8
+
+	node struct {
+		kind  nodeKind
+		hash  hash.Hash256
+		key   hash.Hash256
+		value []byte
+		left  hash.Hash256
+		right hash.Hash256
+	}
+
+	kv struct {
+		key   hash.Hash256
+		value []byte
+	}
+)
+
+const (
+	kindEmpty nodeKind = iota
+	kindLeaf
+	kindInternal
+)
+
+// NewTree opens the tree of root in store, root is ZeroHash256 for an empty tree
+func NewTree(store Store, root hash.Hash256) *Tree {
+	return &Tree{
+		store: store,
+		root:  root,
+	}
+}
+
+// Root returns the root of tree
+func (t *Tree) Root() hash.Hash256 {
+	return t.root
+}
+
+// Get returns the value of key, or ErrKeyNotFound if key is not in the tree
+func (t *Tree) Get(key hash.Hash256) ([]byte, error) {
+	h := t.root
+	for d := 0; ; d++ {
+		n, err := t.load(h)
+		if err != nil {
+			return nil, err
+		}
+		switch n.kind {
+		case kindEmpty:
+			return nil, ErrKeyNotFound
+		case kindLeaf:
+			if n.key != key {
+				return nil, ErrKeyNotFound
+			}
+			return n.value, nil
+		}
+		h = n.child(bit(key, d))
+	}
+}
+
+// Update sets the value of key, an empty value deletes the key
+func (t *Tree) Update(key hash.Hash256, value []byte) error {
+	return t.UpdateBatch(map[hash.Hash256][]byte{key: value})
+}
+
+// Delete deletes the key, it is a no-op if key is not in the tree
+func (t *Tree) Delete(key hash.Hash256) error {
+	return t.Update(key, nil)
+}
+
+// UpdateBatch sets the values of keys in one pass, an empty value deletes the key
+//
+// nodes shared by the paths of keys are written only once, which is much faster
+// than updating the keys one by one
+func (t *Tree) UpdateBatch(kvs map[hash.Hash256][]byte) error {
+	if len(kvs) == 0 {
+		return nil
+	}
+	ops := make([]kv, 0, len(kvs))
+	for k, v := range kvs {
+		ops = append(ops, kv{k, v})
+	}
+	sort.Slice(ops, func(i, j int) bool {
+		return bytes.Compare(ops[i].key[:], ops[j].key[:]) < 0
+	})
+	n, err := t.update(t.root, 0, ops)
+	if err != nil {
+		return err
+	}
+	t.root = n.hash
+	return nil
+}
+
+// update applies the sorted ops to the subtree of h at depth d
+func (t *Tree) update(h hash.Hash256, d int, ops []kv) (*node, error) {
+	n, err := t.load(h)
+	if err != nil || len(ops) == 0 {
+		return n, err
+	}
+	switch n.kind {
+	case kindEmpty:
+		return t.build(d, ops)
+	case kindLeaf:
+		// the existing leaf is kept, unless it is updated by ops
+		i := sort.Search(len(ops), func(i int) bool {
+			return bytes.Compare(ops[i].key[:], n.key[:]) >= 0
+		})
+		if i == len(ops) || ops[i].key != n.key {
+			merged := make([]kv, 0, len(ops)+1)
+			merged = append(merged, ops[:i]...)
+			merged = append(merged, kv{n.key, n.value})
+			ops = append(merged, ops[i:]...)
+		}
+		return t.build(d, ops)
+	}
+	i := splitOps(ops, d)
+	left, err := t.update(n.left, d+1, ops[:i])
+	if err != nil {
+		return nil, err
+	}
+	right, err := t.update(n.right, d+1, ops[i:])
+	if err != nil {
+		return nil, err
+	}
+	return t.join(left, right)
+}
+
+// build creates the subtree at depth d of the sorted ops, which is empty
+func (t *Tree) build(d int, ops []kv) (*node, error) {
+	live := make([]kv, 0, len(ops))
+	for _, op := range ops {
+		if len(op.value) > 0 {
+			live = append(live, op)
+		}
+	}
+	return t.buildLive(d, live)
+}
+
+func (t *Tree) buildLive(d int, ops []kv) (*node, error) {
+	switch len(ops) {
+	case 0:
+		return &node{kind: kindEmpty}, nil
+	case 1:
+		return t.putLeaf(ops[0].key, ops[0].value)
+	}
+	i := splitOps(ops, d)
+	left, err := t.buildLive(d+1, ops[:i])
+	if err != nil {
+		return nil, err
+	}
+	right, err := t.buildLive(d+1, ops[i:])
+	if err != nil {
+		return nil, err
+	}
+	return t.join(left, right)
+}
+
+// join returns the parent of left and right, a leaf whose sibling is empty is moved
+// up to replace the parent
+func (t *Tree) join(left, right *node) (*node, error) {
+	switch {
+	case left.kind == kindEmpty && right.kind == kindEmpty:
+		return left, nil
+	case left.kind == kindEmpty && right.kind == kindLeaf:
+		return right, nil
+	case right.kind == kindEmpty && left.kind == kindLeaf:
+		return left, nil
+	}
+	n := &node{
+		kind:  kindInternal,
+		hash:  internalHash(left.hash, right.hash),
+		left:  left.hash,
+		right: right.hash,
+	}
+	b := make([]byte, 0, 1+2*len(n.left))
+	b = append(b, internalPrefix)
+	b = append(b, n.left[:]...)
+	b = append(b, n.right[:]...)
+	if err := t.store.Put(n.hash, b); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (t *Tree) putLeaf(key hash.Hash256, value []byte) (*node, error) {
+	n := &node{
+		kind:  kindLeaf,
+		hash:  leafHash(key, hash.Hash256b(value)),
+		key:   key,
+		value: value,
+	}
+	b := make([]byte, 0, 1+len(key)+len(value))
+	b = append(b, leafPrefix)
+	b = append(b, key[:]...)
+	b = append(b, value...)
+	if err := t.store.Put(n.hash, b); err != nil {
+		return nil, err
+	}
+	return n, nil
+}
+
+func (t *Tree) load(h hash.Hash256) (*node, error) {
+	if h == hash.ZeroHash256 {
+		return &node{kind: kindEmpty}, nil
+	}
+	b, err := t.store.Get(h)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to load node %x", h[:])
+	}
+	n := &node{hash: h}
+	switch {
+	case len(b) > 1+len(n.key) && b[0] == leafPrefix:
+		n.kind = kindLeaf
+		copy(n.key[:], b[1:])
+		n.value = b[1+len(n.key):]
+	case len(b) == 1+2*len(n.left) && b[0] == internalPrefix:
+		n.kind = kindInternal
+		copy(n.left[:], b[1:])
+		copy(n.right[:], b[1+len(n.left):])
+	default:
+		return nil, errors.Errorf("invalid node %x", h[:])
+	}
+	return n, nil
+}
+
+func (n *node) child(b byte) hash.Hash256 {
+	if b == 0 {
+		return n.left
+	}
+	return n.right
+}
+
+// splitOps returns the index of first op whose key has bit 1 at depth d
+func splitOps(ops []kv, d int) int {
+	return sort.Search(len(ops), func(i int) bool {
+		return bit(ops[i].key, d) == 1
+	})
+}
+
+func bit(key hash.Hash256, d int) byte {
+	return key[d>>3] >> (7 - d&7) & 1
+}
+
+func leafHash(key, valueHash hash.Hash256) hash.Hash256 {
+	b := make([]byte, 0, 1+2*len(key))
+	b = append(b, leafPrefix)
+	b = append(b, key[:]...)
+	b = append(b, valueHash[:]...)
+	return hash.Hash256b(b)
+}
+
+func internalHash(left, right hash.Hash256) hash.Hash256 {
+	b := make([]byte, 0, 1+2*len(left))
+	b = append(b, internalPrefix)
+	b = append(b, left[:]...)
+	b = append(b, right[:]...)
+	return hash.Hash256b(b)
+}
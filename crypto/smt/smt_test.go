@@ -0,0 +1,150 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package smt
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+func testKV(n int) ([]hash.Hash256, [][]byte) {
+	var (
+		keys   []hash.Hash256
+		values [][]byte
+	)
+	for i := 0; i < n; i++ {
+		keys = append(keys, hash.Hash256b([]byte{byte(i), byte(i >> 8)}))
+		values = append(values, []byte{'v', byte(i)})
+	}
+	return keys, values
+}
+
+func TestTree(t *testing.T) {
+	require := require.New(t)
+
+	store := NewMemStore()
+	tr := NewTree(store, hash.ZeroHash256)
+	require.Equal(hash.ZeroHash256, tr.Root())
+	_, err := tr.Get(hash.ZeroHash256)
+	require.Equal(ErrKeyNotFound, err)
+
+	keys, values := testKV(200)
+	for i := range keys {
+		require.NoError(tr.Update(keys[i], values[i]))
+	}
+	root := tr.Root()
+	for i := range keys {
+		v, err := tr.Get(keys[i])
+		require.NoError(err)
+		require.Equal(values[i], v)
+	}
+	_, err = tr.Get(hash.Hash256b([]byte("absent")))
+	require.Equal(ErrKeyNotFound, err)
+
+	// root is independent of the order of updates
+	tr2 := NewTree(NewMemStore(), hash.ZeroHash256)
+	for i := len(keys) - 1; i >= 0; i-- {
+		require.NoError(tr2.Update(keys[i], []byte("x")))
+		require.NoError(tr2.Update(keys[i], values[i]))
+	}
+	require.Equal(root, tr2.Root())
+
+	// same as batch update
+	tr3 := NewTree(NewMemStore(), hash.ZeroHash256)
+	kvs := make(map[hash.Hash256][]byte)
+	for i := range keys {
+		kvs[keys[i]] = values[i]
+	}
+	require.NoError(tr3.UpdateBatch(kvs))
+	require.Equal(root, tr3.Root())
+	require.NoError(tr3.UpdateBatch(nil))
+	require.Equal(root, tr3.Root())
+
+	// delete
+	require.NoError(tr.Delete(hash.Hash256b([]byte("absent"))))
+	require.Equal(root, tr.Root())
+	for i := 0; i < 100; i++ {
+		require.NoError(tr.Delete(keys[i]))
+		_, err = tr.Get(keys[i])
+		require.Equal(ErrKeyNotFound, err)
+	}
+	tr4 := NewTree(NewMemStore(), hash.ZeroHash256)
+	kvs = make(map[hash.Hash256][]byte)
+	for i := 100; i < 200; i++ {
+		kvs[keys[i]] = values[i]
+	}
+	require.NoError(tr4.UpdateBatch(kvs))
+	require.Equal(tr4.Root(), tr.Root())
+
+	// batch of mixed update and delete
+	kvs = make(map[hash.Hash256][]byte)
+	for i := range keys {
+		if i < 100 {
+			kvs[keys[i]] = values[i]
+		} else {
+			kvs[keys[i]] = nil
+		}
+	}
+	require.NoError(tr3.UpdateBatch(kvs))
+	require.NoError(tr4.Update(keys[0], []byte{}))
+	for i := 0; i < 100; i++ {
+		require.NoError(tr4.Update(keys[i], values[i]))
+	}
+	for i := 100; i < 200; i++ {
+		require.NoError(tr4.Delete(keys[i]))
+	}
+	require.Equal(tr3.Root(), tr4.Root())
+	for i := 100; i < 200; i++ {
+		require.NoError(tr3.Delete(keys[i-100]))
+	}
+	require.Equal(hash.ZeroHash256, tr3.Root())
+
+	// previous root remains readable
+	old := NewTree(store, root)
+	for i := range keys {
+		v, err := old.Get(keys[i])
+		require.NoError(err)
+		require.Equal(values[i], v)
+	}
+
+	// missing node
+	_, err = NewTree(NewMemStore(), root).Get(keys[0])
+	require.ErrorIs(err, ErrNotFound)
+}
+
+func TestTreeSharedPrefix(t *testing.T) {
+	require := require.New(t)
+
+	// keys differ only in the last bit
+	var k1, k2 hash.Hash256
+	k1[31], k2[31] = 0xf0, 0xf1
+	tr := NewTree(NewMemStore(), hash.ZeroHash256)
+	require.NoError(tr.Update(k1, []byte("a")))
+	single := tr.Root()
+	require.Equal(leafHash(k1, hash.Hash256b([]byte("a"))), single)
+	require.NoError(tr.Update(k2, []byte("b")))
+
+	root := internalHash(leafHash(k1, hash.Hash256b([]byte("a"))), leafHash(k2, hash.Hash256b([]byte("b"))))
+	for d := Depth - 2; d >= 0; d-- {
+		if bit(k1, d) == 0 {
+			root = internalHash(root, hash.ZeroHash256)
+		} else {
+			root = internalHash(hash.ZeroHash256, root)
+		}
+	}
+	require.Equal(root, tr.Root())
+	v, err := tr.Get(k2)
+	require.NoError(err)
+	require.Equal([]byte("b"), v)
+
+	// the remaining leaf is moved up to root
+	require.NoError(tr.Delete(k2))
+	require.Equal(single, tr.Root())
+}
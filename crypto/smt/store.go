@@ -0,0 +1,64 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package smt
+
+import (
+	"sync"
+
+	"github.com/iotexproject/go-pkgs/hash"
+)
+
+type (
+	// Store is the storage of tree nodes, keyed by node hash
+	//
+	// nodes are never overwritten with different data or deleted by the tree, so
+	// the trees of previous roots remain readable. Get returns ErrNotFound if the
+	// node does not exist
+	Store interface {
+		Get(hash.Hash256) ([]byte, error)
+		Put(hash.Hash256, []byte) error
+	}
+
+	// MemStore is the in-memory Store, it is safe for concurrent use
+	MemStore struct {
+		mu    sync.RWMutex
+		nodes map[hash.Hash256][]byte
+	}
+)
+
+// NewMemStore creates an empty in-memory store
+func NewMemStore() *MemStore {
+	return &MemStore{
+		nodes: make(map[hash.Hash256][]byte),
+	}
+}
+
+// Get returns the node of hash
+func (s *MemStore) Get(h hash.Hash256) ([]byte, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	b, ok := s.nodes[h]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	return append([]byte(nil), b...), nil
+}
+
+// Put stores the node of hash
+func (s *MemStore) Put(h hash.Hash256, b []byte) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.nodes[h] = append([]byte(nil), b...)
+	return nil
+}
+
+// Len returns the number of nodes
+func (s *MemStore) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.nodes)
+}
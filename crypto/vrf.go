@@ -0,0 +1,205 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/sha256"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+// ECVRF per RFC 9381, with secp256k1 in place of P-256 in the ECVRF-P256-SHA256-TAI
+// suite, i.e. try-and-increment encode to curve, RFC 6979 nonce generation with
+// SHA-256, and SEC1 compressed point encoding
+const (
+	// VRFProofSize is the size of proof pi = Gamma (33) || c (16) || s (32)
+	VRFProofSize = vrfPtLen + vrfCLen + vrfQLen
+	// VRFOutputSize is the size of output beta
+	VRFOutputSize = sha256.Size
+
+	// vrfSuite is the suite string, outside the ones assigned by RFC 9381
+	vrfSuite = 0xfe
+	vrfPtLen = secp256pubKeyCompressedLength
+	vrfCLen  = 16
+	vrfQLen  = 32
+)
+
+// ErrVRFProof indicates the VRF proof is invalid
+var ErrVRFProof = errors.New("invalid vrf proof")
+
+// VRFProve computes the VRF output beta and proof pi of the input alpha, only
+// secp256k1 private key is supported
+//
+// beta is unpredictable without the private key yet verifiable by anyone with pi,
+// so it can be used as the seed of SortCandidates for unbiasable leader election
+func VRFProve(sk PrivateKey, alpha []byte) ([]byte, []byte, error) {
+	k, ok := sk.(*secp256k1PrvKey)
+	if !ok {
+		return nil, nil, errors.Wrapf(ErrInvalidKey, "unsupported private key type %T", sk)
+	}
+	var (
+		curve = crypto.S256()
+		n     = curve.Params().N
+		x     = k.D
+		pk    = k.PublicKey().CompressedBytes()
+	)
+	hx, hy, err := vrfEncodeToCurve(pk, alpha)
+	if err != nil {
+		return nil, nil, err
+	}
+	hs := vrfPointToBytes(hx, hy)
+	gx, gy := curve.ScalarMult(hx, hy, vrfScalar(x))
+
+	h1 := sha256.Sum256(hs)
+	nonce := newRFC6979Nonce(sha256.New, n, x, h1[:])()
+	ux, uy := curve.ScalarBaseMult(vrfScalar(nonce))
+	vx, vy := curve.ScalarMult(hx, hy, vrfScalar(nonce))
+	gs := vrfPointToBytes(gx, gy)
+	c := vrfChallenge(pk, hs, gs, vrfPointToBytes(ux, uy), vrfPointToBytes(vx, vy))
+
+	// s = (k + c*x) mod q
+	s := new(big.Int).Mul(c, x)
+	s.Add(s, nonce)
+	s.Mod(s, n)
+
+	pi := make([]byte, 0, VRFProofSize)
+	pi = append(pi, gs...)
+	pi = append(pi, c.FillBytes(make([]byte, vrfCLen))...)
+	pi = append(pi, s.FillBytes(make([]byte, vrfQLen))...)
+	return vrfProofToHash(gs), pi, nil
+}
+
+// VRFVerify verifies the proof pi of input alpha against the public key, and
+// returns the VRF output beta if the proof is valid
+func VRFVerify(pk PublicKey, alpha, pi []byte) ([]byte, error) {
+	k, ok := pk.(*secp256k1PubKey)
+	if !ok {
+		return nil, errors.Wrapf(ErrInvalidKey, "unsupported public key type %T", pk)
+	}
+	y, err := k.ecdsaPublicKey()
+	if err != nil {
+		return nil, err
+	}
+	gx, gy, c, s, err := vrfDecodeProof(pi)
+	if err != nil {
+		return nil, err
+	}
+	var (
+		curve = crypto.S256()
+		n     = curve.Params().N
+		pks   = pk.CompressedBytes()
+	)
+	hx, hy, err := vrfEncodeToCurve(pks, alpha)
+	if err != nil {
+		return nil, err
+	}
+
+	// U = s*B - c*Y, V = s*H - c*Gamma
+	negC := vrfScalar(new(big.Int).Sub(n, c))
+	sbx, sby := curve.ScalarBaseMult(vrfScalar(s))
+	cyx, cyy := curve.ScalarMult(y.X, y.Y, negC)
+	ux, uy := vrfAdd(sbx, sby, cyx, cyy)
+	shx, shy := curve.ScalarMult(hx, hy, vrfScalar(s))
+	cgx, cgy := curve.ScalarMult(gx, gy, negC)
+	vx, vy := vrfAdd(shx, shy, cgx, cgy)
+	if ux == nil || vx == nil {
+		return nil, ErrVRFProof
+	}
+	gs := pi[:vrfPtLen]
+	if vrfChallenge(pks, vrfPointToBytes(hx, hy), gs, vrfPointToBytes(ux, uy), vrfPointToBytes(vx, vy)).Cmp(c) != 0 {
+		return nil, ErrVRFProof
+	}
+	return vrfProofToHash(gs), nil
+}
+
+// VRFProofToHash returns the VRF output beta of the proof pi, without verifying
+// the proof
+func VRFProofToHash(pi []byte) ([]byte, error) {
+	if _, _, _, _, err := vrfDecodeProof(pi); err != nil {
+		return nil, err
+	}
+	return vrfProofToHash(pi[:vrfPtLen]), nil
+}
+
+func vrfProofToHash(gamma []byte) []byte {
+	// cofactor of secp256k1 is 1
+	h := sha256.New()
+	h.Write([]byte{vrfSuite, 0x03})
+	h.Write(gamma)
+	h.Write([]byte{0x00})
+	return h.Sum(nil)
+}
+
+// vrfEncodeToCurve hashes the input to a curve point, per try-and-increment method
+func vrfEncodeToCurve(pk, alpha []byte) (*big.Int, *big.Int, error) {
+	for ctr := 0; ctr < 256; ctr++ {
+		h := sha256.New()
+		h.Write([]byte{vrfSuite, 0x01})
+		h.Write(pk)
+		h.Write(alpha)
+		h.Write([]byte{byte(ctr), 0x00})
+		p, err := crypto.DecompressPubkey(append([]byte{0x02}, h.Sum(nil)...))
+		if err == nil {
+			return p.X, p.Y, nil
+		}
+	}
+	// happens with probability 2^-256
+	return nil, nil, errors.New("failed to encode vrf input to curve")
+}
+
+func vrfChallenge(points ...[]byte) *big.Int {
+	h := sha256.New()
+	h.Write([]byte{vrfSuite, 0x02})
+	for _, p := range points {
+		h.Write(p)
+	}
+	h.Write([]byte{0x00})
+	return new(big.Int).SetBytes(h.Sum(nil)[:vrfCLen])
+}
+
+func vrfDecodeProof(pi []byte) (*big.Int, *big.Int, *big.Int, *big.Int, error) {
+	if len(pi) != VRFProofSize {
+		return nil, nil, nil, nil, errors.Wrapf(ErrVRFProof, "invalid length %d", len(pi))
+	}
+	gamma, err := crypto.DecompressPubkey(pi[:vrfPtLen])
+	if err != nil {
+		return nil, nil, nil, nil, errors.Wrap(ErrVRFProof, "invalid gamma")
+	}
+	c := new(big.Int).SetBytes(pi[vrfPtLen : vrfPtLen+vrfCLen])
+	s := new(big.Int).SetBytes(pi[vrfPtLen+vrfCLen:])
+	// zero c or s only results from a forged proof
+	if c.Sign() == 0 || s.Sign() == 0 || s.Cmp(crypto.S256().Params().N) >= 0 {
+		return nil, nil, nil, nil, errors.Wrap(ErrVRFProof, "invalid scalar")
+	}
+	return gamma.X, gamma.Y, c, s, nil
+}
+
+func vrfPointToBytes(x, y *big.Int) []byte {
+	b := make([]byte, vrfPtLen)
+	b[0] = 2 + byte(y.Bit(0))
+	x.FillBytes(b[1:])
+	return b
+}
+
+func vrfScalar(v *big.Int) []byte {
+	return v.FillBytes(make([]byte, vrfQLen))
+}
+
+// vrfAdd adds two points, it takes care of doubling and returns nil for the
+// point at infinity, which the curve's Add does not handle
+func vrfAdd(x1, y1, x2, y2 *big.Int) (*big.Int, *big.Int) {
+	curve := crypto.S256()
+	if x1.Cmp(x2) != 0 {
+		return curve.Add(x1, y1, x2, y2)
+	}
+	if y1.Cmp(y2) == 0 {
+		return curve.Double(x1, y1)
+	}
+	return nil, nil
+}
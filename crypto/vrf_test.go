@@ -0,0 +1,93 @@
+// Copyright (c) 2026 IoTeX
+// This is an alpha (internal) release and is not suitable for production. This source code is provided 'as is' and no
+// warranties are given as to title or non-infringement, merchantability or fitness for purpose and, to the extent
+// permitted by law, all liability for your use of the code is disclaimed. This source code is governed by Apache
+// License 2.0 that can be found in the LICENSE file.
+
+package crypto
+
+import (
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRFC6979Nonce(t *testing.T) {
+	require := require.New(t)
+
+	// test vector in RFC 6979 A.2.5, P-256 with SHA-256, message "sample"
+	x, _ := new(big.Int).SetString("C9AFA9D845BA75166B5C215767B1D6934E50C3DB36E89B127B8A622B120F6721", 16)
+	h1 := sha256.Sum256([]byte("sample"))
+	k := newRFC6979Nonce(sha256.New, elliptic.P256().Params().N, x, h1[:])()
+	require.Equal("a6e3c57dd01abe90086538398355dd4c3b17aa873382b0f24d6129493d8aad60", hex.EncodeToString(k.Bytes()))
+}
+
+func TestVRF(t *testing.T) {
+	require := require.New(t)
+
+	sk, err := HexStringToPrivateKey("c9afa9d845ba75166b5c215767b1d6934e50c3db36e89b127b8a622b120f6721")
+	require.NoError(err)
+	pk := sk.PublicKey()
+	alpha := []byte("sample")
+	beta, pi, err := VRFProve(sk, alpha)
+	require.NoError(err)
+	require.Equal(VRFProofSize, len(pi))
+	require.Equal(VRFOutputSize, len(beta))
+	require.Equal("0338ec99b5d0f94ebcc2c704c04af3de8b4289df8798e5fb9f920d7f5d77ac03d7718b9677d1c9348649ac2ec4f7ecbe519b30dd10c4eb5efc21dd5944709f2f3b7e97a25f6f095334593502d05103bc5b", hex.EncodeToString(pi))
+	require.Equal("d466c22e14dc3b7fd169668dd3ee9ac6351429a24aebc5e8af61a0f0de89b65a", hex.EncodeToString(beta))
+
+	// proof is deterministic
+	beta1, pi1, err := VRFProve(sk, alpha)
+	require.NoError(err)
+	require.Equal(beta, beta1)
+	require.Equal(pi, pi1)
+
+	beta1, err = VRFVerify(pk, alpha, pi)
+	require.NoError(err)
+	require.Equal(beta, beta1)
+	beta1, err = VRFProofToHash(pi)
+	require.NoError(err)
+	require.Equal(beta, beta1)
+
+	// different input yields different output
+	beta1, pi1, err = VRFProve(sk, []byte("test"))
+	require.NoError(err)
+	require.NotEqual(beta, beta1)
+	_, err = VRFVerify(pk, alpha, pi1)
+	require.ErrorIs(err, ErrVRFProof)
+
+	// wrong key
+	sk1, err := GenerateKey()
+	require.NoError(err)
+	_, err = VRFVerify(sk1.PublicKey(), alpha, pi)
+	require.ErrorIs(err, ErrVRFProof)
+
+	// tampered proof
+	for _, i := range []int{0, 1, vrfPtLen, VRFProofSize - 1} {
+		b := make([]byte, len(pi))
+		copy(b, pi)
+		b[i] ^= 1
+		_, err = VRFVerify(pk, alpha, b)
+		require.ErrorIs(err, ErrVRFProof)
+	}
+	_, err = VRFVerify(pk, alpha, pi[1:])
+	require.ErrorIs(err, ErrVRFProof)
+	_, err = VRFProofToHash(pi[1:])
+	require.ErrorIs(err, ErrVRFProof)
+
+	// unsupported key type
+	sk2, err := GenerateKeySm2()
+	require.NoError(err)
+	_, _, err = VRFProve(sk2, alpha)
+	require.ErrorIs(err, ErrInvalidKey)
+	_, err = VRFVerify(sk2.PublicKey(), alpha, pi)
+	require.ErrorIs(err, ErrInvalidKey)
+
+	// public key off curve
+	_, err = VRFVerify(&secp256k1PubKey{raw: offCurveSecp256k1Key()}, alpha, pi)
+	require.ErrorIs(err, ErrPublicKey)
+}